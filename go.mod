@@ -6,7 +6,9 @@ require (
 	github.com/ability-sh/abi-lib v1.0.1
 	github.com/ability-sh/abi-micro v1.0.2
 	github.com/google/uuid v1.3.0
+	go.etcd.io/bbolt v1.3.6
 	go.mongodb.org/mongo-driver v1.10.0
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013
 	google.golang.org/grpc v1.48.0
 	google.golang.org/protobuf v1.28.0
 )
@@ -35,6 +37,5 @@ require (
 	golang.org/x/sys v0.0.0-20211216021012-1d35b9e2eb4e // indirect
 	golang.org/x/text v0.3.7 // indirect
 	golang.org/x/time v0.0.0-20220609170525-579cf78fd858 // indirect
-	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 )
@@ -13,6 +13,7 @@ import (
 	_ "github.com/ability-sh/abi-micro/oss"
 	_ "github.com/ability-sh/abi-micro/redis"
 	"github.com/ability-sh/abi-micro/runtime"
+	G "google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -36,7 +37,9 @@ func main() {
 		log.Panicln(err)
 	}
 
-	s := grpc.NewServer(p)
+	s := G.NewServer(
+		G.ChainUnaryInterceptor(grpc.NewUnaryServerInterceptor(p), srv.NewUnaryAuthInterceptor(), srv.NewUnaryStatusInterceptor(), srv.NewUnaryIdempotencyInterceptor()),
+		G.ChainStreamInterceptor(srv.NewStreamServerInterceptor(p), srv.NewStreamAuthInterceptor()))
 
 	srv.Reg(s)
 
@@ -20,6 +20,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// *
+// 版本状态，draft → uploaded → testing → published → deprecated → yanked
+type VerStatus int32
+
+const (
+	VerStatus_VER_DRAFT      VerStatus = 0
+	VerStatus_VER_UPLOADED   VerStatus = 1
+	VerStatus_VER_TESTING    VerStatus = 2
+	VerStatus_VER_PUBLISHED  VerStatus = 3
+	VerStatus_VER_DEPRECATED VerStatus = 4
+	VerStatus_VER_YANKED     VerStatus = 5
+)
+
+// Enum value maps for VerStatus.
+var (
+	VerStatus_name = map[int32]string{
+		0: "VER_DRAFT",
+		1: "VER_UPLOADED",
+		2: "VER_TESTING",
+		3: "VER_PUBLISHED",
+		4: "VER_DEPRECATED",
+		5: "VER_YANKED",
+	}
+	VerStatus_value = map[string]int32{
+		"VER_DRAFT":      0,
+		"VER_UPLOADED":   1,
+		"VER_TESTING":    2,
+		"VER_PUBLISHED":  3,
+		"VER_DEPRECATED": 4,
+		"VER_YANKED":     5,
+	}
+)
+
+func (x VerStatus) Enum() *VerStatus {
+	p := new(VerStatus)
+	*p = x
+	return p
+}
+
+func (x VerStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_uv_pb_app_proto_enumTypes[0].Descriptor()
+}
+
+func (VerStatus) Type() protoreflect.EnumType {
+	return &file_uv_pb_app_proto_enumTypes[0]
+}
+
+func (x VerStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerStatus.Descriptor instead.
+func (VerStatus) EnumDescriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{0}
+}
+
+// *
+// 关键字匹配方式，MATCH_REGEX 为正则表达式，受长度及复杂度限制，其余方式关键字按字面量匹配
+type FilterMatch int32
+
+const (
+	FilterMatch_MATCH_CONTAINS FilterMatch = 0
+	FilterMatch_MATCH_PREFIX   FilterMatch = 1
+	FilterMatch_MATCH_TEXT     FilterMatch = 2
+	FilterMatch_MATCH_REGEX    FilterMatch = 3
+)
+
+// Enum value maps for FilterMatch.
+var (
+	FilterMatch_name = map[int32]string{
+		0: "MATCH_CONTAINS",
+		1: "MATCH_PREFIX",
+		2: "MATCH_TEXT",
+		3: "MATCH_REGEX",
+	}
+	FilterMatch_value = map[string]int32{
+		"MATCH_CONTAINS": 0,
+		"MATCH_PREFIX":   1,
+		"MATCH_TEXT":     2,
+		"MATCH_REGEX":    3,
+	}
+)
+
+func (x FilterMatch) Enum() *FilterMatch {
+	p := new(FilterMatch)
+	*p = x
+	return p
+}
+
+func (x FilterMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FilterMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_uv_pb_app_proto_enumTypes[1].Descriptor()
+}
+
+func (FilterMatch) Type() protoreflect.EnumType {
+	return &file_uv_pb_app_proto_enumTypes[1]
+}
+
+func (x FilterMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterMatch.Descriptor instead.
+func (FilterMatch) EnumDescriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{1}
+}
+
 type App struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -30,6 +144,7 @@ type App struct {
 	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	Info   string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 	Ctime  int32  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Rev    int32  `protobuf:"varint,6,opt,name=rev,proto3" json:"rev,omitempty"`
 }
 
 func (x *App) Reset() {
@@ -99,23 +214,104 @@ func (x *App) GetCtime() int32 {
 	return 0
 }
 
+func (x *App) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+type VerTransition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  VerStatus `protobuf:"varint,1,opt,name=from,proto3,enum=app.VerStatus" json:"from,omitempty"`
+	To    VerStatus `protobuf:"varint,2,opt,name=to,proto3,enum=app.VerStatus" json:"to,omitempty"`
+	Actor string    `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Ctime int32     `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *VerTransition) Reset() {
+	*x = VerTransition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerTransition) ProtoMessage() {}
+
+func (x *VerTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerTransition.ProtoReflect.Descriptor instead.
+func (*VerTransition) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerTransition) GetFrom() VerStatus {
+	if x != nil {
+		return x.From
+	}
+	return VerStatus_VER_DRAFT
+}
+
+func (x *VerTransition) GetTo() VerStatus {
+	if x != nil {
+		return x.To
+	}
+	return VerStatus_VER_DRAFT
+}
+
+func (x *VerTransition) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *VerTransition) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
 type Ver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Ver    string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
-	Title  string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Info   string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Ctime  int32  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
-	Status int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Appid       string           `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver         string           `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Title       string           `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Info        string           `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Ctime       int32            `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Status      VerStatus        `protobuf:"varint,6,opt,name=status,proto3,enum=app.VerStatus" json:"status,omitempty"`
+	Rev         int32            `protobuf:"varint,7,opt,name=rev,proto3" json:"rev,omitempty"`
+	Packages    []*Package       `protobuf:"bytes,8,rep,name=packages,proto3" json:"packages,omitempty"`
+	Transitions []*VerTransition `protobuf:"bytes,9,rep,name=transitions,proto3" json:"transitions,omitempty"`
 }
 
 func (x *Ver) Reset() {
 	*x = Ver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[1]
+		mi := &file_uv_pb_app_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -128,7 +324,7 @@ func (x *Ver) String() string {
 func (*Ver) ProtoMessage() {}
 
 func (x *Ver) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[1]
+	mi := &file_uv_pb_app_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -141,7 +337,7 @@ func (x *Ver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ver.ProtoReflect.Descriptor instead.
 func (*Ver) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{1}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Ver) GetAppid() string {
@@ -179,13 +375,121 @@ func (x *Ver) GetCtime() int32 {
 	return 0
 }
 
-func (x *Ver) GetStatus() int32 {
+func (x *Ver) GetStatus() VerStatus {
 	if x != nil {
 		return x.Status
 	}
+	return VerStatus_VER_DRAFT
+}
+
+func (x *Ver) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+func (x *Ver) GetPackages() []*Package {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+func (x *Ver) GetTransitions() []*VerTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+type Package struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ability  string `protobuf:"bytes,1,opt,name=ability,proto3" json:"ability,omitempty"`
+	Size     int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256   string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Etag     string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	Mtime    int32  `protobuf:"varint,5,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Verified bool   `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *Package) Reset() {
+	*x = Package{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package) ProtoMessage() {}
+
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Package) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *Package) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Package) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Package) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Package) GetMtime() int32 {
+	if x != nil {
+		return x.Mtime
+	}
 	return 0
 }
 
+func (x *Package) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
 type Container struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -197,12 +501,13 @@ type Container struct {
 	Info   string            `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
 	Env    map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Ctime  int32             `protobuf:"varint,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Rev    int32             `protobuf:"varint,7,opt,name=rev,proto3" json:"rev,omitempty"`
 }
 
 func (x *Container) Reset() {
 	*x = Container{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[2]
+		mi := &file_uv_pb_app_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -215,7 +520,7 @@ func (x *Container) String() string {
 func (*Container) ProtoMessage() {}
 
 func (x *Container) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[2]
+	mi := &file_uv_pb_app_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +533,7 @@ func (x *Container) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Container.ProtoReflect.Descriptor instead.
 func (*Container) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{2}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Container) GetId() string {
@@ -273,24 +578,34 @@ func (x *Container) GetCtime() int32 {
 	return 0
 }
 
+func (x *Container) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
 type Ac struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Appid string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
-	Ver   string            `protobuf:"bytes,3,opt,name=ver,proto3" json:"ver,omitempty"`
-	Title string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Info  string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
-	Env   map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Ctime int32             `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Cid      string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid    string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver      string            `protobuf:"bytes,3,opt,name=ver,proto3" json:"ver,omitempty"`
+	Title    string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Info     string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	Env      map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ctime    int32             `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Rev      int32             `protobuf:"varint,8,opt,name=rev,proto3" json:"rev,omitempty"`
+	VerRange string            `protobuf:"bytes,9,opt,name=verRange,proto3" json:"verRange,omitempty"`
+	Channel  string            `protobuf:"bytes,10,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (x *Ac) Reset() {
 	*x = Ac{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[3]
+		mi := &file_uv_pb_app_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -303,7 +618,7 @@ func (x *Ac) String() string {
 func (*Ac) ProtoMessage() {}
 
 func (x *Ac) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[3]
+	mi := &file_uv_pb_app_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +631,7 @@ func (x *Ac) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Ac.ProtoReflect.Descriptor instead.
 func (*Ac) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{3}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Ac) GetCid() string {
@@ -368,34 +683,58 @@ func (x *Ac) GetCtime() int32 {
 	return 0
 }
 
-type Page struct {
+func (x *Ac) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+func (x *Ac) GetVerRange() string {
+	if x != nil {
+		return x.VerRange
+	}
+	return ""
+}
+
+func (x *Ac) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+type Removed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count      int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	P          int32 `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
-	N          int32 `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
-	TotalCount int32 `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	Ver         int32    `protobuf:"varint,1,opt,name=ver,proto3" json:"ver,omitempty"`
+	Ac          int32    `protobuf:"varint,2,opt,name=ac,proto3" json:"ac,omitempty"`
+	Oss         int32    `protobuf:"varint,3,opt,name=oss,proto3" json:"oss,omitempty"`
+	Channel     int32    `protobuf:"varint,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Rollout     int32    `protobuf:"varint,5,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	RolloutItem int32    `protobuf:"varint,6,opt,name=rolloutItem,proto3" json:"rolloutItem,omitempty"`
+	OssFailed   []string `protobuf:"bytes,7,rep,name=ossFailed,proto3" json:"ossFailed,omitempty"` // 删除失败的包文件，数据已删除，需稍后清理
 }
 
-func (x *Page) Reset() {
-	*x = Page{}
+func (x *Removed) Reset() {
+	*x = Removed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[4]
+		mi := &file_uv_pb_app_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Page) String() string {
+func (x *Removed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Page) ProtoMessage() {}
+func (*Removed) ProtoMessage() {}
 
-func (x *Page) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[4]
+func (x *Removed) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,54 +745,317 @@ func (x *Page) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Page.ProtoReflect.Descriptor instead.
-func (*Page) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use Removed.ProtoReflect.Descriptor instead.
+func (*Removed) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Page) GetCount() int32 {
+func (x *Removed) GetVer() int32 {
 	if x != nil {
-		return x.Count
+		return x.Ver
 	}
 	return 0
 }
 
-func (x *Page) GetP() int32 {
+func (x *Removed) GetAc() int32 {
 	if x != nil {
-		return x.P
+		return x.Ac
 	}
 	return 0
 }
 
-func (x *Page) GetN() int32 {
+func (x *Removed) GetOss() int32 {
 	if x != nil {
-		return x.N
+		return x.Oss
 	}
 	return 0
 }
 
-func (x *Page) GetTotalCount() int32 {
+func (x *Removed) GetChannel() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Channel
 	}
 	return 0
 }
 
-type AppQueryResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Removed) GetRollout() int32 {
+	if x != nil {
+		return x.Rollout
+	}
+	return 0
+}
 
-	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
-	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Page   *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
-	Items  []*App `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+func (x *Removed) GetRolloutItem() int32 {
+	if x != nil {
+		return x.RolloutItem
+	}
+	return 0
+}
+
+func (x *Removed) GetOssFailed() []string {
+	if x != nil {
+		return x.OssFailed
+	}
+	return nil
+}
+
+type Page struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count      int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	P          int32 `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32 `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	TotalCount int32 `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+}
+
+func (x *Page) Reset() {
+	*x = Page{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Page) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page) ProtoMessage() {}
+
+func (x *Page) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page.ProtoReflect.Descriptor instead.
+func (*Page) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Page) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Page) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *Page) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *Page) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// *
+// info 字段条件，op 为 eq ne gt gte lt lte in nin exists，value 为 JSON
+type InfoPredicate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Op    string `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *InfoPredicate) Reset() {
+	*x = InfoPredicate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InfoPredicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InfoPredicate) ProtoMessage() {}
+
+func (x *InfoPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InfoPredicate.ProtoReflect.Descriptor instead.
+func (*InfoPredicate) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InfoPredicate) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *InfoPredicate) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *InfoPredicate) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// *
+// 查询过滤条件
+type Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Statuses   []int32          `protobuf:"varint,1,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	CtimeStart int32            `protobuf:"varint,2,opt,name=ctimeStart,proto3" json:"ctimeStart,omitempty"`
+	CtimeEnd   int32            `protobuf:"varint,3,opt,name=ctimeEnd,proto3" json:"ctimeEnd,omitempty"`
+	Title      string           `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Q          string           `protobuf:"bytes,5,opt,name=q,proto3" json:"q,omitempty"`
+	Match      FilterMatch      `protobuf:"varint,6,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	Info       []*InfoPredicate `protobuf:"bytes,7,rep,name=info,proto3" json:"info,omitempty"`
+	IgnoreCase bool             `protobuf:"varint,8,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+}
+
+func (x *Filter) Reset() {
+	*x = Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filter) ProtoMessage() {}
+
+func (x *Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filter.ProtoReflect.Descriptor instead.
+func (*Filter) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Filter) GetStatuses() []int32 {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Filter) GetCtimeStart() int32 {
+	if x != nil {
+		return x.CtimeStart
+	}
+	return 0
+}
+
+func (x *Filter) GetCtimeEnd() int32 {
+	if x != nil {
+		return x.CtimeEnd
+	}
+	return 0
+}
+
+func (x *Filter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Filter) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *Filter) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *Filter) GetInfo() []*InfoPredicate {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *Filter) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+type AppQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*App `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *AppQueryResult) Reset() {
 	*x = AppQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[5]
+		mi := &file_uv_pb_app_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -466,7 +1068,7 @@ func (x *AppQueryResult) String() string {
 func (*AppQueryResult) ProtoMessage() {}
 
 func (x *AppQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[5]
+	mi := &file_uv_pb_app_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +1081,7 @@ func (x *AppQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppQueryResult.ProtoReflect.Descriptor instead.
 func (*AppQueryResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{5}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AppQueryResult) GetErrno() int32 {
@@ -510,6 +1112,13 @@ func (x *AppQueryResult) GetItems() []*App {
 	return nil
 }
 
+func (x *AppQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type VerQueryResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -519,12 +1128,13 @@ type VerQueryResult struct {
 	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
 	Page   *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	Items  []*Ver `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *VerQueryResult) Reset() {
 	*x = VerQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[6]
+		mi := &file_uv_pb_app_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +1147,7 @@ func (x *VerQueryResult) String() string {
 func (*VerQueryResult) ProtoMessage() {}
 
 func (x *VerQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[6]
+	mi := &file_uv_pb_app_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +1160,7 @@ func (x *VerQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerQueryResult.ProtoReflect.Descriptor instead.
 func (*VerQueryResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{6}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *VerQueryResult) GetErrno() int32 {
@@ -581,6 +1191,13 @@ func (x *VerQueryResult) GetItems() []*Ver {
 	return nil
 }
 
+func (x *VerQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type ContainerQueryResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -590,12 +1207,13 @@ type ContainerQueryResult struct {
 	Errmsg string       `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
 	Page   *Page        `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	Items  []*Container `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string       `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *ContainerQueryResult) Reset() {
 	*x = ContainerQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[7]
+		mi := &file_uv_pb_app_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -608,7 +1226,7 @@ func (x *ContainerQueryResult) String() string {
 func (*ContainerQueryResult) ProtoMessage() {}
 
 func (x *ContainerQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[7]
+	mi := &file_uv_pb_app_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +1239,7 @@ func (x *ContainerQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerQueryResult.ProtoReflect.Descriptor instead.
 func (*ContainerQueryResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{7}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ContainerQueryResult) GetErrno() int32 {
@@ -652,6 +1270,13 @@ func (x *ContainerQueryResult) GetItems() []*Container {
 	return nil
 }
 
+func (x *ContainerQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type AcQueryResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -661,12 +1286,13 @@ type AcQueryResult struct {
 	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
 	Page   *Page  `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
 	Items  []*Ac  `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *AcQueryResult) Reset() {
 	*x = AcQueryResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[8]
+		mi := &file_uv_pb_app_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -679,7 +1305,7 @@ func (x *AcQueryResult) String() string {
 func (*AcQueryResult) ProtoMessage() {}
 
 func (x *AcQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[8]
+	mi := &file_uv_pb_app_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -692,7 +1318,7 @@ func (x *AcQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcQueryResult.ProtoReflect.Descriptor instead.
 func (*AcQueryResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{8}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AcQueryResult) GetErrno() int32 {
@@ -723,19 +1349,27 @@ func (x *AcQueryResult) GetItems() []*Ac {
 	return nil
 }
 
+func (x *AcQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type AppCreateTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Info  string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Info           string `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *AppCreateTask) Reset() {
 	*x = AppCreateTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[9]
+		mi := &file_uv_pb_app_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -748,7 +1382,7 @@ func (x *AppCreateTask) String() string {
 func (*AppCreateTask) ProtoMessage() {}
 
 func (x *AppCreateTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[9]
+	mi := &file_uv_pb_app_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +1395,7 @@ func (x *AppCreateTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppCreateTask.ProtoReflect.Descriptor instead.
 func (*AppCreateTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{9}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AppCreateTask) GetTitle() string {
@@ -778,6 +1412,13 @@ func (x *AppCreateTask) GetInfo() string {
 	return ""
 }
 
+func (x *AppCreateTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type AppGetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -789,7 +1430,7 @@ type AppGetTask struct {
 func (x *AppGetTask) Reset() {
 	*x = AppGetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[10]
+		mi := &file_uv_pb_app_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -802,7 +1443,7 @@ func (x *AppGetTask) String() string {
 func (*AppGetTask) ProtoMessage() {}
 
 func (x *AppGetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[10]
+	mi := &file_uv_pb_app_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1456,7 @@ func (x *AppGetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppGetTask.ProtoReflect.Descriptor instead.
 func (*AppGetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{10}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AppGetTask) GetAppid() string {
@@ -830,15 +1471,20 @@ type AppQueryTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
-	P int32  `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
-	N int32  `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Q          string      `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	P          int32       `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32       `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Filter     *Filter     `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Match      FilterMatch `protobuf:"varint,5,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	IgnoreCase bool        `protobuf:"varint,6,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+	Cursor     string      `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      bool        `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *AppQueryTask) Reset() {
 	*x = AppQueryTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[11]
+		mi := &file_uv_pb_app_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -851,7 +1497,7 @@ func (x *AppQueryTask) String() string {
 func (*AppQueryTask) ProtoMessage() {}
 
 func (x *AppQueryTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[11]
+	mi := &file_uv_pb_app_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1510,7 @@ func (x *AppQueryTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppQueryTask.ProtoReflect.Descriptor instead.
 func (*AppQueryTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{11}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AppQueryTask) GetQ() string {
@@ -888,21 +1534,58 @@ func (x *AppQueryTask) GetN() int32 {
 	return 0
 }
 
+func (x *AppQueryTask) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AppQueryTask) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *AppQueryTask) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *AppQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AppQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
 type AppSetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Info   string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Secret bool   `protobuf:"varint,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Info        string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Secret      bool   `protobuf:"varint,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Upsert      bool   `protobuf:"varint,5,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,6,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *AppSetTask) Reset() {
 	*x = AppSetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[12]
+		mi := &file_uv_pb_app_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1598,7 @@ func (x *AppSetTask) String() string {
 func (*AppSetTask) ProtoMessage() {}
 
 func (x *AppSetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[12]
+	mi := &file_uv_pb_app_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1611,7 @@ func (x *AppSetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppSetTask.ProtoReflect.Descriptor instead.
 func (*AppSetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{12}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AppSetTask) GetAppid() string {
@@ -959,18 +1642,36 @@ func (x *AppSetTask) GetSecret() bool {
 	return false
 }
 
+func (x *AppSetTask) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *AppSetTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type AppRemoveTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Appid       string   `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Cascade     bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	Archive     bool     `protobuf:"varint,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	Ability     []string `protobuf:"bytes,4,rep,name=ability,proto3" json:"ability,omitempty"`
+	ExpectedRev int32    `protobuf:"varint,5,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *AppRemoveTask) Reset() {
 	*x = AppRemoveTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[13]
+		mi := &file_uv_pb_app_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -983,7 +1684,7 @@ func (x *AppRemoveTask) String() string {
 func (*AppRemoveTask) ProtoMessage() {}
 
 func (x *AppRemoveTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[13]
+	mi := &file_uv_pb_app_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1697,7 @@ func (x *AppRemoveTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppRemoveTask.ProtoReflect.Descriptor instead.
 func (*AppRemoveTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{13}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AppRemoveTask) GetAppid() string {
@@ -1006,20 +1707,49 @@ func (x *AppRemoveTask) GetAppid() string {
 	return ""
 }
 
+func (x *AppRemoveTask) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *AppRemoveTask) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *AppRemoveTask) GetAbility() []string {
+	if x != nil {
+		return x.Ability
+	}
+	return nil
+}
+
+func (x *AppRemoveTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type AppResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
-	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Data   *App   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Errno   int32    `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg  string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data    *App     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Removed *Removed `protobuf:"bytes,4,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
 func (x *AppResult) Reset() {
 	*x = AppResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[14]
+		mi := &file_uv_pb_app_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1032,7 +1762,7 @@ func (x *AppResult) String() string {
 func (*AppResult) ProtoMessage() {}
 
 func (x *AppResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[14]
+	mi := &file_uv_pb_app_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +1775,7 @@ func (x *AppResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppResult.ProtoReflect.Descriptor instead.
 func (*AppResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{14}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AppResult) GetErrno() int32 {
@@ -1069,23 +1799,32 @@ func (x *AppResult) GetData() *App {
 	return nil
 }
 
+func (x *AppResult) GetRemoved() *Removed {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
 type VerCreateTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Ver    string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
-	Title  string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Info   string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
-	Ctime  int32  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
-	Status int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Appid          string    `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver            string    `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Title          string    `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Info           string    `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	Ctime          int32     `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Status         VerStatus `protobuf:"varint,6,opt,name=status,proto3,enum=app.VerStatus" json:"status,omitempty"`
+	IfNotExists    bool      `protobuf:"varint,7,opt,name=ifNotExists,proto3" json:"ifNotExists,omitempty"`
+	IdempotencyKey string    `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *VerCreateTask) Reset() {
 	*x = VerCreateTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[15]
+		mi := &file_uv_pb_app_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +1837,7 @@ func (x *VerCreateTask) String() string {
 func (*VerCreateTask) ProtoMessage() {}
 
 func (x *VerCreateTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[15]
+	mi := &file_uv_pb_app_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1850,7 @@ func (x *VerCreateTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerCreateTask.ProtoReflect.Descriptor instead.
 func (*VerCreateTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{15}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *VerCreateTask) GetAppid() string {
@@ -1149,11 +1888,25 @@ func (x *VerCreateTask) GetCtime() int32 {
 	return 0
 }
 
-func (x *VerCreateTask) GetStatus() int32 {
+func (x *VerCreateTask) GetStatus() VerStatus {
 	if x != nil {
 		return x.Status
 	}
-	return 0
+	return VerStatus_VER_DRAFT
+}
+
+func (x *VerCreateTask) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
+func (x *VerCreateTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
 }
 
 type VerGetTask struct {
@@ -1168,7 +1921,7 @@ type VerGetTask struct {
 func (x *VerGetTask) Reset() {
 	*x = VerGetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[16]
+		mi := &file_uv_pb_app_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1181,7 +1934,7 @@ func (x *VerGetTask) String() string {
 func (*VerGetTask) ProtoMessage() {}
 
 func (x *VerGetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[16]
+	mi := &file_uv_pb_app_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,7 +1947,7 @@ func (x *VerGetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerGetTask.ProtoReflect.Descriptor instead.
 func (*VerGetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{16}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *VerGetTask) GetAppid() string {
@@ -1216,17 +1969,23 @@ type VerQueryTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Q      string `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
-	P      int32  `protobuf:"varint,3,opt,name=p,proto3" json:"p,omitempty"`
-	N      int32  `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Appid      string      `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Q          string      `protobuf:"bytes,2,opt,name=q,proto3" json:"q,omitempty"`
+	P          int32       `protobuf:"varint,3,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32       `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
+	Status     string      `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Sort       string      `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	Filter     *Filter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Match      FilterMatch `protobuf:"varint,8,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	IgnoreCase bool        `protobuf:"varint,9,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+	Cursor     string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      bool        `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *VerQueryTask) Reset() {
 	*x = VerQueryTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[17]
+		mi := &file_uv_pb_app_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1239,7 +1998,7 @@ func (x *VerQueryTask) String() string {
 func (*VerQueryTask) ProtoMessage() {}
 
 func (x *VerQueryTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[17]
+	mi := &file_uv_pb_app_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +2011,7 @@ func (x *VerQueryTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerQueryTask.ProtoReflect.Descriptor instead.
 func (*VerQueryTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{17}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *VerQueryTask) GetAppid() string {
@@ -1290,22 +2049,129 @@ func (x *VerQueryTask) GetStatus() string {
 	return ""
 }
 
+func (x *VerQueryTask) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *VerQueryTask) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *VerQueryTask) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *VerQueryTask) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *VerQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *VerQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+type VerResolveTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid     string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Range     string `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Published bool   `protobuf:"varint,3,opt,name=published,proto3" json:"published,omitempty"`
+}
+
+func (x *VerResolveTask) Reset() {
+	*x = VerResolveTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerResolveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerResolveTask) ProtoMessage() {}
+
+func (x *VerResolveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerResolveTask.ProtoReflect.Descriptor instead.
+func (*VerResolveTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerResolveTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *VerResolveTask) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *VerResolveTask) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
 type VerSetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Ver    string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
-	Info   string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	Title  string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver         string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Info        string `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Status      string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // VerStatus 名称(VER_PUBLISHED、published)或数值，空为不修改；VER_DRAFT 为 0，枚举类型无法区分未设置，故保留字符串
+	Upsert      bool   `protobuf:"varint,6,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,7,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *VerSetTask) Reset() {
 	*x = VerSetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[18]
+		mi := &file_uv_pb_app_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1318,7 +2184,7 @@ func (x *VerSetTask) String() string {
 func (*VerSetTask) ProtoMessage() {}
 
 func (x *VerSetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[18]
+	mi := &file_uv_pb_app_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1331,7 +2197,7 @@ func (x *VerSetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerSetTask.ProtoReflect.Descriptor instead.
 func (*VerSetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{18}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *VerSetTask) GetAppid() string {
@@ -1369,19 +2235,34 @@ func (x *VerSetTask) GetStatus() string {
 	return ""
 }
 
+func (x *VerSetTask) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *VerSetTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type VerRemoveTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
-	Ver   string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver         string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,3,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *VerRemoveTask) Reset() {
 	*x = VerRemoveTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[19]
+		mi := &file_uv_pb_app_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1394,7 +2275,7 @@ func (x *VerRemoveTask) String() string {
 func (*VerRemoveTask) ProtoMessage() {}
 
 func (x *VerRemoveTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[19]
+	mi := &file_uv_pb_app_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,7 +2288,7 @@ func (x *VerRemoveTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerRemoveTask.ProtoReflect.Descriptor instead.
 func (*VerRemoveTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{19}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *VerRemoveTask) GetAppid() string {
@@ -1424,6 +2305,13 @@ func (x *VerRemoveTask) GetVer() string {
 	return ""
 }
 
+func (x *VerRemoveTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type VerGetURLTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1438,7 +2326,7 @@ type VerGetURLTask struct {
 func (x *VerGetURLTask) Reset() {
 	*x = VerGetURLTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[20]
+		mi := &file_uv_pb_app_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1451,7 +2339,7 @@ func (x *VerGetURLTask) String() string {
 func (*VerGetURLTask) ProtoMessage() {}
 
 func (x *VerGetURLTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[20]
+	mi := &file_uv_pb_app_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +2352,7 @@ func (x *VerGetURLTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerGetURLTask.ProtoReflect.Descriptor instead.
 func (*VerGetURLTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{20}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *VerGetURLTask) GetAppid() string {
@@ -1508,7 +2396,7 @@ type VerGetURLResult struct {
 func (x *VerGetURLResult) Reset() {
 	*x = VerGetURLResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[21]
+		mi := &file_uv_pb_app_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1521,7 +2409,7 @@ func (x *VerGetURLResult) String() string {
 func (*VerGetURLResult) ProtoMessage() {}
 
 func (x *VerGetURLResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[21]
+	mi := &file_uv_pb_app_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,7 +2422,7 @@ func (x *VerGetURLResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerGetURLResult.ProtoReflect.Descriptor instead.
 func (*VerGetURLResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{21}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *VerGetURLResult) GetErrno() int32 {
@@ -1572,7 +2460,7 @@ type VerUpURLTask struct {
 func (x *VerUpURLTask) Reset() {
 	*x = VerUpURLTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[22]
+		mi := &file_uv_pb_app_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1585,7 +2473,7 @@ func (x *VerUpURLTask) String() string {
 func (*VerUpURLTask) ProtoMessage() {}
 
 func (x *VerUpURLTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[22]
+	mi := &file_uv_pb_app_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,7 +2486,7 @@ func (x *VerUpURLTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerUpURLTask.ProtoReflect.Descriptor instead.
 func (*VerUpURLTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{22}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *VerUpURLTask) GetAppid() string {
@@ -1629,6 +2517,156 @@ func (x *VerUpURLTask) GetExpires() int32 {
 	return 0
 }
 
+type VerUpConfirmTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid   string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver     string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Ability string `protobuf:"bytes,3,opt,name=ability,proto3" json:"ability,omitempty"`
+	Etag    string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	Sha256  string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *VerUpConfirmTask) Reset() {
+	*x = VerUpConfirmTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerUpConfirmTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerUpConfirmTask) ProtoMessage() {}
+
+func (x *VerUpConfirmTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerUpConfirmTask.ProtoReflect.Descriptor instead.
+func (*VerUpConfirmTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VerUpConfirmTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *VerUpConfirmTask) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *VerUpConfirmTask) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *VerUpConfirmTask) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *VerUpConfirmTask) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type VerVerifyTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid    string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Ver      string `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Ability  string `protobuf:"bytes,3,opt,name=ability,proto3" json:"ability,omitempty"`
+	Manifest string `protobuf:"bytes,4,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *VerVerifyTask) Reset() {
+	*x = VerVerifyTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerVerifyTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerVerifyTask) ProtoMessage() {}
+
+func (x *VerVerifyTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerVerifyTask.ProtoReflect.Descriptor instead.
+func (*VerVerifyTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VerVerifyTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *VerVerifyTask) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *VerVerifyTask) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *VerVerifyTask) GetManifest() string {
+	if x != nil {
+		return x.Manifest
+	}
+	return ""
+}
+
 type VerUpURL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1643,7 +2681,7 @@ type VerUpURL struct {
 func (x *VerUpURL) Reset() {
 	*x = VerUpURL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[23]
+		mi := &file_uv_pb_app_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1656,7 +2694,7 @@ func (x *VerUpURL) String() string {
 func (*VerUpURL) ProtoMessage() {}
 
 func (x *VerUpURL) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[23]
+	mi := &file_uv_pb_app_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +2707,7 @@ func (x *VerUpURL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerUpURL.ProtoReflect.Descriptor instead.
 func (*VerUpURL) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{23}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *VerUpURL) GetUrl() string {
@@ -1713,7 +2751,7 @@ type VerUpURLResult struct {
 func (x *VerUpURLResult) Reset() {
 	*x = VerUpURLResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[24]
+		mi := &file_uv_pb_app_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1726,7 +2764,7 @@ func (x *VerUpURLResult) String() string {
 func (*VerUpURLResult) ProtoMessage() {}
 
 func (x *VerUpURLResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[24]
+	mi := &file_uv_pb_app_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1739,7 +2777,7 @@ func (x *VerUpURLResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerUpURLResult.ProtoReflect.Descriptor instead.
 func (*VerUpURLResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{24}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *VerUpURLResult) GetErrno() int32 {
@@ -1776,7 +2814,7 @@ type VerResult struct {
 func (x *VerResult) Reset() {
 	*x = VerResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[25]
+		mi := &file_uv_pb_app_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1789,7 +2827,7 @@ func (x *VerResult) String() string {
 func (*VerResult) ProtoMessage() {}
 
 func (x *VerResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[25]
+	mi := &file_uv_pb_app_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,7 +2840,7 @@ func (x *VerResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerResult.ProtoReflect.Descriptor instead.
 func (*VerResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{25}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *VerResult) GetErrno() int32 {
@@ -1831,15 +2869,16 @@ type ContainerCreateTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Info  string            `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	Env   map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Title          string            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Info           string            `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Env            map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IdempotencyKey string            `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *ContainerCreateTask) Reset() {
 	*x = ContainerCreateTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[26]
+		mi := &file_uv_pb_app_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1852,7 +2891,7 @@ func (x *ContainerCreateTask) String() string {
 func (*ContainerCreateTask) ProtoMessage() {}
 
 func (x *ContainerCreateTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[26]
+	mi := &file_uv_pb_app_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,7 +2904,7 @@ func (x *ContainerCreateTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerCreateTask.ProtoReflect.Descriptor instead.
 func (*ContainerCreateTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{26}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ContainerCreateTask) GetTitle() string {
@@ -1889,6 +2928,13 @@ func (x *ContainerCreateTask) GetEnv() map[string]string {
 	return nil
 }
 
+func (x *ContainerCreateTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type ContainerGetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1900,7 +2946,7 @@ type ContainerGetTask struct {
 func (x *ContainerGetTask) Reset() {
 	*x = ContainerGetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[27]
+		mi := &file_uv_pb_app_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1913,7 +2959,7 @@ func (x *ContainerGetTask) String() string {
 func (*ContainerGetTask) ProtoMessage() {}
 
 func (x *ContainerGetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[27]
+	mi := &file_uv_pb_app_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1926,7 +2972,7 @@ func (x *ContainerGetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerGetTask.ProtoReflect.Descriptor instead.
 func (*ContainerGetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{27}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ContainerGetTask) GetCid() string {
@@ -1941,15 +2987,20 @@ type ContainerQueryTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
-	P int32  `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
-	N int32  `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Q          string      `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	P          int32       `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32       `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Filter     *Filter     `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Match      FilterMatch `protobuf:"varint,5,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	IgnoreCase bool        `protobuf:"varint,6,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+	Cursor     string      `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      bool        `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *ContainerQueryTask) Reset() {
 	*x = ContainerQueryTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[28]
+		mi := &file_uv_pb_app_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1962,7 +3013,7 @@ func (x *ContainerQueryTask) String() string {
 func (*ContainerQueryTask) ProtoMessage() {}
 
 func (x *ContainerQueryTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[28]
+	mi := &file_uv_pb_app_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,7 +3026,7 @@ func (x *ContainerQueryTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerQueryTask.ProtoReflect.Descriptor instead.
 func (*ContainerQueryTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{28}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ContainerQueryTask) GetQ() string {
@@ -1999,22 +3050,59 @@ func (x *ContainerQueryTask) GetN() int32 {
 	return 0
 }
 
+func (x *ContainerQueryTask) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ContainerQueryTask) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *ContainerQueryTask) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *ContainerQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ContainerQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
 type ContainerSetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid    string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Info   string            `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	Secret bool              `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
-	Env    map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Title  string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Cid         string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Info        string            `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	Secret      bool              `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Env         map[string]string `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Title       string            `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Upsert      bool              `protobuf:"varint,6,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	ExpectedRev int32             `protobuf:"varint,7,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *ContainerSetTask) Reset() {
 	*x = ContainerSetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[29]
+		mi := &file_uv_pb_app_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2027,7 +3115,7 @@ func (x *ContainerSetTask) String() string {
 func (*ContainerSetTask) ProtoMessage() {}
 
 func (x *ContainerSetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[29]
+	mi := &file_uv_pb_app_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2040,7 +3128,7 @@ func (x *ContainerSetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerSetTask.ProtoReflect.Descriptor instead.
 func (*ContainerSetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{29}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ContainerSetTask) GetCid() string {
@@ -2078,18 +3166,35 @@ func (x *ContainerSetTask) GetTitle() string {
 	return ""
 }
 
+func (x *ContainerSetTask) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *ContainerSetTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type ContainerRemoveTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Cascade     bool   `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	Archive     bool   `protobuf:"varint,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,4,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *ContainerRemoveTask) Reset() {
 	*x = ContainerRemoveTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[30]
+		mi := &file_uv_pb_app_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2102,7 +3207,7 @@ func (x *ContainerRemoveTask) String() string {
 func (*ContainerRemoveTask) ProtoMessage() {}
 
 func (x *ContainerRemoveTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[30]
+	mi := &file_uv_pb_app_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2115,7 +3220,7 @@ func (x *ContainerRemoveTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerRemoveTask.ProtoReflect.Descriptor instead.
 func (*ContainerRemoveTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{30}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ContainerRemoveTask) GetCid() string {
@@ -2125,20 +3230,42 @@ func (x *ContainerRemoveTask) GetCid() string {
 	return ""
 }
 
+func (x *ContainerRemoveTask) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *ContainerRemoveTask) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *ContainerRemoveTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
 type ContainerResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errno  int32      `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
-	Errmsg string     `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Data   *Container `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Errno   int32      `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg  string     `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data    *Container `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Removed *Removed   `protobuf:"bytes,4,opt,name=removed,proto3" json:"removed,omitempty"`
 }
 
 func (x *ContainerResult) Reset() {
 	*x = ContainerResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[31]
+		mi := &file_uv_pb_app_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2151,7 +3278,7 @@ func (x *ContainerResult) String() string {
 func (*ContainerResult) ProtoMessage() {}
 
 func (x *ContainerResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[31]
+	mi := &file_uv_pb_app_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,7 +3291,7 @@ func (x *ContainerResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerResult.ProtoReflect.Descriptor instead.
 func (*ContainerResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{31}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ContainerResult) GetErrno() int32 {
@@ -2188,23 +3315,33 @@ func (x *ContainerResult) GetData() *Container {
 	return nil
 }
 
+func (x *ContainerResult) GetRemoved() *Removed {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
 type AcAddTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Appid string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
-	Title string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Ver   string            `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
-	Info  string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
-	Env   map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cid            string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid          string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Title          string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Ver            string            `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
+	Info           string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	Env            map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Channel        string            `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	IfNotExists    bool              `protobuf:"varint,8,opt,name=ifNotExists,proto3" json:"ifNotExists,omitempty"`
+	IdempotencyKey string            `protobuf:"bytes,9,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *AcAddTask) Reset() {
 	*x = AcAddTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[32]
+		mi := &file_uv_pb_app_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2217,7 +3354,7 @@ func (x *AcAddTask) String() string {
 func (*AcAddTask) ProtoMessage() {}
 
 func (x *AcAddTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[32]
+	mi := &file_uv_pb_app_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2230,7 +3367,7 @@ func (x *AcAddTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcAddTask.ProtoReflect.Descriptor instead.
 func (*AcAddTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{32}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AcAddTask) GetCid() string {
@@ -2275,6 +3412,27 @@ func (x *AcAddTask) GetEnv() map[string]string {
 	return nil
 }
 
+func (x *AcAddTask) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AcAddTask) GetIfNotExists() bool {
+	if x != nil {
+		return x.IfNotExists
+	}
+	return false
+}
+
+func (x *AcAddTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type AcGetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2287,7 +3445,7 @@ type AcGetTask struct {
 func (x *AcGetTask) Reset() {
 	*x = AcGetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[33]
+		mi := &file_uv_pb_app_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2300,7 +3458,7 @@ func (x *AcGetTask) String() string {
 func (*AcGetTask) ProtoMessage() {}
 
 func (x *AcGetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[33]
+	mi := &file_uv_pb_app_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2313,7 +3471,7 @@ func (x *AcGetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcGetTask.ProtoReflect.Descriptor instead.
 func (*AcGetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{33}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *AcGetTask) GetCid() string {
@@ -2335,17 +3493,23 @@ type AcQueryTask struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Appid string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
-	Q     string `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
-	P     int32  `protobuf:"varint,4,opt,name=p,proto3" json:"p,omitempty"`
-	N     int32  `protobuf:"varint,5,opt,name=n,proto3" json:"n,omitempty"`
+	Cid        string      `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid      string      `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Q          string      `protobuf:"bytes,3,opt,name=q,proto3" json:"q,omitempty"`
+	P          int32       `protobuf:"varint,4,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32       `protobuf:"varint,5,opt,name=n,proto3" json:"n,omitempty"`
+	Channel    string      `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Filter     *Filter     `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Match      FilterMatch `protobuf:"varint,8,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	IgnoreCase bool        `protobuf:"varint,9,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+	Cursor     string      `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      bool        `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *AcQueryTask) Reset() {
 	*x = AcQueryTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[34]
+		mi := &file_uv_pb_app_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2358,7 +3522,7 @@ func (x *AcQueryTask) String() string {
 func (*AcQueryTask) ProtoMessage() {}
 
 func (x *AcQueryTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[34]
+	mi := &file_uv_pb_app_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,7 +3535,7 @@ func (x *AcQueryTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcQueryTask.ProtoReflect.Descriptor instead.
 func (*AcQueryTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{34}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AcQueryTask) GetCid() string {
@@ -2409,23 +3573,68 @@ func (x *AcQueryTask) GetN() int32 {
 	return 0
 }
 
+func (x *AcQueryTask) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AcQueryTask) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *AcQueryTask) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *AcQueryTask) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *AcQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AcQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
 type AcSetTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Appid string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
-	Title string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Ver   string            `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
-	Info  string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
-	Env   map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cid         string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid       string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Title       string            `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Ver         string            `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
+	Info        string            `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+	Env         map[string]string `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Upsert      bool              `protobuf:"varint,7,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	ExpectedRev int32             `protobuf:"varint,8,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
+	Channel     string            `protobuf:"bytes,9,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
 func (x *AcSetTask) Reset() {
 	*x = AcSetTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[35]
+		mi := &file_uv_pb_app_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2438,7 +3647,7 @@ func (x *AcSetTask) String() string {
 func (*AcSetTask) ProtoMessage() {}
 
 func (x *AcSetTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[35]
+	mi := &file_uv_pb_app_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2451,7 +3660,7 @@ func (x *AcSetTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcSetTask.ProtoReflect.Descriptor instead.
 func (*AcSetTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{35}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *AcSetTask) GetCid() string {
@@ -2496,19 +3705,41 @@ func (x *AcSetTask) GetEnv() map[string]string {
 	return nil
 }
 
+func (x *AcSetTask) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *AcSetTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+func (x *AcSetTask) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
 type AcRemoveTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Appid string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid       string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,3,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
 }
 
 func (x *AcRemoveTask) Reset() {
 	*x = AcRemoveTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[36]
+		mi := &file_uv_pb_app_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2521,7 +3752,7 @@ func (x *AcRemoveTask) String() string {
 func (*AcRemoveTask) ProtoMessage() {}
 
 func (x *AcRemoveTask) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[36]
+	mi := &file_uv_pb_app_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2534,7 +3765,7 @@ func (x *AcRemoveTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcRemoveTask.ProtoReflect.Descriptor instead.
 func (*AcRemoveTask) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{36}
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *AcRemoveTask) GetCid() string {
@@ -2551,548 +3782,6293 @@ func (x *AcRemoveTask) GetAppid() string {
 	return ""
 }
 
-type AcResult struct {
+func (x *AcRemoveTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+type AcHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
-	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
-	Data   *Ac    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Cid      string            `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid    string            `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Rev      int32             `protobuf:"varint,3,opt,name=rev,proto3" json:"rev,omitempty"`
+	Ver      string            `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
+	VerRange string            `protobuf:"bytes,5,opt,name=verRange,proto3" json:"verRange,omitempty"`
+	Channel  string            `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	Env      map[string]string `protobuf:"bytes,7,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Method   string            `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
+	Actor    string            `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`
+	Ctime    int32             `protobuf:"varint,10,opt,name=ctime,proto3" json:"ctime,omitempty"`
 }
 
-func (x *AcResult) Reset() {
-	*x = AcResult{}
+func (x *AcHistory) Reset() {
+	*x = AcHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_uv_pb_app_proto_msgTypes[37]
+		mi := &file_uv_pb_app_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AcResult) String() string {
+func (x *AcHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcResult) ProtoMessage() {}
+func (*AcHistory) ProtoMessage() {}
 
-func (x *AcResult) ProtoReflect() protoreflect.Message {
-	mi := &file_uv_pb_app_proto_msgTypes[37]
+func (x *AcHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcHistory.ProtoReflect.Descriptor instead.
+func (*AcHistory) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AcHistory) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AcHistory) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *AcHistory) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+func (x *AcHistory) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *AcHistory) GetVerRange() string {
+	if x != nil {
+		return x.VerRange
+	}
+	return ""
+}
+
+func (x *AcHistory) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AcHistory) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *AcHistory) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AcHistory) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AcHistory) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type AcRollbackTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid       string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Steps       int32  `protobuf:"varint,3,opt,name=steps,proto3" json:"steps,omitempty"`
+	ToRev       int32  `protobuf:"varint,4,opt,name=toRev,proto3" json:"toRev,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,5,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
+}
+
+func (x *AcRollbackTask) Reset() {
+	*x = AcRollbackTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcRollbackTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcRollbackTask) ProtoMessage() {}
+
+func (x *AcRollbackTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcRollbackTask.ProtoReflect.Descriptor instead.
+func (*AcRollbackTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AcRollbackTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AcRollbackTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *AcRollbackTask) GetSteps() int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *AcRollbackTask) GetToRev() int32 {
+	if x != nil {
+		return x.ToRev
+	}
+	return 0
+}
+
+func (x *AcRollbackTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+type AcHistoryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid   string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	P     int32  `protobuf:"varint,3,opt,name=p,proto3" json:"p,omitempty"`
+	N     int32  `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *AcHistoryTask) Reset() {
+	*x = AcHistoryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcHistoryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcHistoryTask) ProtoMessage() {}
+
+func (x *AcHistoryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AcResult.ProtoReflect.Descriptor instead.
-func (*AcResult) Descriptor() ([]byte, []int) {
-	return file_uv_pb_app_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *AcResult) GetErrno() int32 {
-	if x != nil {
-		return x.Errno
-	}
-	return 0
-}
-
-func (x *AcResult) GetErrmsg() string {
-	if x != nil {
-		return x.Errmsg
-	}
-	return ""
-}
-
-func (x *AcResult) GetData() *Ac {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-var File_uv_pb_app_proto protoreflect.FileDescriptor
-
-var file_uv_pb_app_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x75, 0x76, 0x2d, 0x70, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x03, 0x61, 0x70, 0x70, 0x22, 0x6d, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x03, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
-	0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd6, 0x01,
-	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a,
-	0x03, 0x65, 0x6e, 0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x36,
-	0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xda, 0x01, 0x0a, 0x02, 0x41, 0x63, 0x12, 0x10, 0x0a,
-	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x12, 0x22, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x45,
-	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12,
-	0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x1e, 0x0a,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7d, 0x0a,
-	0x0e, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7d, 0x0a, 0x0e,
-	0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x56, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x14,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d,
-	0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7b, 0x0a, 0x0d, 0x41, 0x63, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22,
-	0x22, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a,
-	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
-	0x70, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
-	0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12,
-	0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x22, 0x64, 0x0a,
-	0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x09, 0x41, 0x70,
-	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
-	0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x8f, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x34, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x0c, 0x56,
-	0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61,
-	0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
-	0x64, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12,
-	0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a,
-	0x01, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a, 0x0d, 0x56,
-	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70,
-	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x76, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x55, 0x52,
-	0x4c, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x73, 0x22, 0x53, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
-	0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d,
-	0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55,
-	0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
-	0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x61, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
-	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
-	0x67, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12,
-	0x1c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xac, 0x01,
-	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
-	0x33, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x03, 0x65, 0x6e, 0x76, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24, 0x0a, 0x10,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
-	0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x01, 0x6e, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x36, 0x0a,
-	0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x63,
-	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12,
-	0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0xd2, 0x01, 0x0a, 0x09, 0x41, 0x63, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x41, 0x64, 0x64, 0x54, 0x61,
-	0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76,
-	0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x41, 0x63, 0x47, 0x65,
-	0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0x5f, 0x0a,
-	0x0b, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x70, 0x70, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70,
-	0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x22, 0xd2,
-	0x01, 0x0a, 0x09, 0x41, 0x63, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
-	0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
-	0x12, 0x29, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e,
-	0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x1a, 0x36, 0x0a, 0x08, 0x45,
-	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x0c, 0x41, 0x63, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x08, 0x41,
-	0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
-	0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x32, 0xe9, 0x08, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f,
-	0x0a, 0x09, 0x41, 0x70, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a,
-	0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x2f, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
-	0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x29, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x41,
-	0x70, 0x70, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x47,
-	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x56, 0x65,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
-	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x56,
-	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56,
-	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06,
-	0x56, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72,
-	0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x47, 0x65,
-	0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x61,
-	0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x11,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73,
-	0x6b, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x55, 0x52, 0x4c, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74,
-	0x55, 0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
-	0x72, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a,
-	0x08, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x2e,
-	0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73,
-	0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x19, 0x2e, 0x61,
-	0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x41, 0x63, 0x41, 0x64, 0x64,
-	0x12, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b,
-	0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x2c, 0x0a, 0x08, 0x41, 0x63, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x61, 0x70,
-	0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a,
-	0x05, 0x41, 0x63, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x53,
-	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x41, 0x63, 0x47, 0x65, 0x74, 0x12, 0x0e,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d,
-	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a,
-	0x07, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41,
-	0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70,
-	0x2e, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x07,
-	0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_uv_pb_app_proto_rawDescOnce sync.Once
-	file_uv_pb_app_proto_rawDescData = file_uv_pb_app_proto_rawDesc
-)
-
-func file_uv_pb_app_proto_rawDescGZIP() []byte {
-	file_uv_pb_app_proto_rawDescOnce.Do(func() {
-		file_uv_pb_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_uv_pb_app_proto_rawDescData)
-	})
-	return file_uv_pb_app_proto_rawDescData
-}
-
-var file_uv_pb_app_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_uv_pb_app_proto_goTypes = []interface{}{
-	(*App)(nil),                  // 0: app.App
-	(*Ver)(nil),                  // 1: app.Ver
-	(*Container)(nil),            // 2: app.Container
-	(*Ac)(nil),                   // 3: app.Ac
-	(*Page)(nil),                 // 4: app.Page
-	(*AppQueryResult)(nil),       // 5: app.AppQueryResult
-	(*VerQueryResult)(nil),       // 6: app.VerQueryResult
-	(*ContainerQueryResult)(nil), // 7: app.ContainerQueryResult
-	(*AcQueryResult)(nil),        // 8: app.AcQueryResult
-	(*AppCreateTask)(nil),        // 9: app.AppCreateTask
-	(*AppGetTask)(nil),           // 10: app.AppGetTask
-	(*AppQueryTask)(nil),         // 11: app.AppQueryTask
-	(*AppSetTask)(nil),           // 12: app.AppSetTask
-	(*AppRemoveTask)(nil),        // 13: app.AppRemoveTask
-	(*AppResult)(nil),            // 14: app.AppResult
-	(*VerCreateTask)(nil),        // 15: app.VerCreateTask
-	(*VerGetTask)(nil),           // 16: app.VerGetTask
-	(*VerQueryTask)(nil),         // 17: app.VerQueryTask
-	(*VerSetTask)(nil),           // 18: app.VerSetTask
-	(*VerRemoveTask)(nil),        // 19: app.VerRemoveTask
-	(*VerGetURLTask)(nil),        // 20: app.VerGetURLTask
-	(*VerGetURLResult)(nil),      // 21: app.VerGetURLResult
-	(*VerUpURLTask)(nil),         // 22: app.VerUpURLTask
-	(*VerUpURL)(nil),             // 23: app.VerUpURL
-	(*VerUpURLResult)(nil),       // 24: app.VerUpURLResult
-	(*VerResult)(nil),            // 25: app.VerResult
-	(*ContainerCreateTask)(nil),  // 26: app.ContainerCreateTask
-	(*ContainerGetTask)(nil),     // 27: app.ContainerGetTask
-	(*ContainerQueryTask)(nil),   // 28: app.ContainerQueryTask
-	(*ContainerSetTask)(nil),     // 29: app.ContainerSetTask
-	(*ContainerRemoveTask)(nil),  // 30: app.ContainerRemoveTask
-	(*ContainerResult)(nil),      // 31: app.ContainerResult
-	(*AcAddTask)(nil),            // 32: app.AcAddTask
-	(*AcGetTask)(nil),            // 33: app.AcGetTask
-	(*AcQueryTask)(nil),          // 34: app.AcQueryTask
-	(*AcSetTask)(nil),            // 35: app.AcSetTask
-	(*AcRemoveTask)(nil),         // 36: app.AcRemoveTask
-	(*AcResult)(nil),             // 37: app.AcResult
-	nil,                          // 38: app.Container.EnvEntry
-	nil,                          // 39: app.Ac.EnvEntry
-	nil,                          // 40: app.VerUpURL.DataEntry
-	nil,                          // 41: app.ContainerCreateTask.EnvEntry
-	nil,                          // 42: app.ContainerSetTask.EnvEntry
-	nil,                          // 43: app.AcAddTask.EnvEntry
-	nil,                          // 44: app.AcSetTask.EnvEntry
-}
-var file_uv_pb_app_proto_depIdxs = []int32{
-	38, // 0: app.Container.env:type_name -> app.Container.EnvEntry
-	39, // 1: app.Ac.env:type_name -> app.Ac.EnvEntry
-	4,  // 2: app.AppQueryResult.page:type_name -> app.Page
-	0,  // 3: app.AppQueryResult.items:type_name -> app.App
-	4,  // 4: app.VerQueryResult.page:type_name -> app.Page
-	1,  // 5: app.VerQueryResult.items:type_name -> app.Ver
-	4,  // 6: app.ContainerQueryResult.page:type_name -> app.Page
-	2,  // 7: app.ContainerQueryResult.items:type_name -> app.Container
-	4,  // 8: app.AcQueryResult.page:type_name -> app.Page
-	3,  // 9: app.AcQueryResult.items:type_name -> app.Ac
-	0,  // 10: app.AppResult.data:type_name -> app.App
-	40, // 11: app.VerUpURL.data:type_name -> app.VerUpURL.DataEntry
-	23, // 12: app.VerUpURLResult.data:type_name -> app.VerUpURL
-	1,  // 13: app.VerResult.data:type_name -> app.Ver
-	41, // 14: app.ContainerCreateTask.env:type_name -> app.ContainerCreateTask.EnvEntry
-	42, // 15: app.ContainerSetTask.env:type_name -> app.ContainerSetTask.EnvEntry
-	2,  // 16: app.ContainerResult.data:type_name -> app.Container
-	43, // 17: app.AcAddTask.env:type_name -> app.AcAddTask.EnvEntry
-	44, // 18: app.AcSetTask.env:type_name -> app.AcSetTask.EnvEntry
-	3,  // 19: app.AcResult.data:type_name -> app.Ac
-	9,  // 20: app.Service.AppCreate:input_type -> app.AppCreateTask
-	13, // 21: app.Service.AppRemove:input_type -> app.AppRemoveTask
-	12, // 22: app.Service.AppSet:input_type -> app.AppSetTask
-	10, // 23: app.Service.AppGet:input_type -> app.AppGetTask
-	11, // 24: app.Service.AppQuery:input_type -> app.AppQueryTask
-	15, // 25: app.Service.VerCreate:input_type -> app.VerCreateTask
-	19, // 26: app.Service.VerRemove:input_type -> app.VerRemoveTask
-	18, // 27: app.Service.VerSet:input_type -> app.VerSetTask
-	16, // 28: app.Service.VerGet:input_type -> app.VerGetTask
-	17, // 29: app.Service.VerQuery:input_type -> app.VerQueryTask
-	20, // 30: app.Service.VerGetURL:input_type -> app.VerGetURLTask
-	22, // 31: app.Service.VerUpURL:input_type -> app.VerUpURLTask
-	26, // 32: app.Service.ContainerCreate:input_type -> app.ContainerCreateTask
-	30, // 33: app.Service.ContainerRemove:input_type -> app.ContainerRemoveTask
-	29, // 34: app.Service.ContainerSet:input_type -> app.ContainerSetTask
-	27, // 35: app.Service.ContainerGet:input_type -> app.ContainerGetTask
-	28, // 36: app.Service.ContainerQuery:input_type -> app.ContainerQueryTask
-	32, // 37: app.Service.AcAdd:input_type -> app.AcAddTask
-	36, // 38: app.Service.AcRemove:input_type -> app.AcRemoveTask
-	35, // 39: app.Service.AcSet:input_type -> app.AcSetTask
-	33, // 40: app.Service.AcGet:input_type -> app.AcGetTask
-	34, // 41: app.Service.AcQuery:input_type -> app.AcQueryTask
-	14, // 42: app.Service.AppCreate:output_type -> app.AppResult
-	14, // 43: app.Service.AppRemove:output_type -> app.AppResult
-	14, // 44: app.Service.AppSet:output_type -> app.AppResult
-	14, // 45: app.Service.AppGet:output_type -> app.AppResult
-	5,  // 46: app.Service.AppQuery:output_type -> app.AppQueryResult
-	25, // 47: app.Service.VerCreate:output_type -> app.VerResult
-	25, // 48: app.Service.VerRemove:output_type -> app.VerResult
-	25, // 49: app.Service.VerSet:output_type -> app.VerResult
-	25, // 50: app.Service.VerGet:output_type -> app.VerResult
-	6,  // 51: app.Service.VerQuery:output_type -> app.VerQueryResult
-	21, // 52: app.Service.VerGetURL:output_type -> app.VerGetURLResult
-	24, // 53: app.Service.VerUpURL:output_type -> app.VerUpURLResult
-	31, // 54: app.Service.ContainerCreate:output_type -> app.ContainerResult
-	31, // 55: app.Service.ContainerRemove:output_type -> app.ContainerResult
-	31, // 56: app.Service.ContainerSet:output_type -> app.ContainerResult
-	31, // 57: app.Service.ContainerGet:output_type -> app.ContainerResult
-	7,  // 58: app.Service.ContainerQuery:output_type -> app.ContainerQueryResult
-	37, // 59: app.Service.AcAdd:output_type -> app.AcResult
-	37, // 60: app.Service.AcRemove:output_type -> app.AcResult
-	37, // 61: app.Service.AcSet:output_type -> app.AcResult
-	37, // 62: app.Service.AcGet:output_type -> app.AcResult
-	8,  // 63: app.Service.AcQuery:output_type -> app.AcQueryResult
-	42, // [42:64] is the sub-list for method output_type
-	20, // [20:42] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
-}
-
-func init() { file_uv_pb_app_proto_init() }
-func file_uv_pb_app_proto_init() {
-	if File_uv_pb_app_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_uv_pb_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*App); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcHistoryTask.ProtoReflect.Descriptor instead.
+func (*AcHistoryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AcHistoryTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AcHistoryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *AcHistoryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *AcHistoryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type AcHistoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32        `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string       `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page        `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*AcHistory `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AcHistoryResult) Reset() {
+	*x = AcHistoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcHistoryResult) ProtoMessage() {}
+
+func (x *AcHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcHistoryResult.ProtoReflect.Descriptor instead.
+func (*AcHistoryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AcHistoryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *AcHistoryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *AcHistoryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *AcHistoryResult) GetItems() []*AcHistory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AcResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *Ac    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *AcResult) Reset() {
+	*x = AcResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcResult) ProtoMessage() {}
+
+func (x *AcResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcResult.ProtoReflect.Descriptor instead.
+func (*AcResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AcResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *AcResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *AcResult) GetData() *Ac {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Channel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ver   string `protobuf:"bytes,3,opt,name=ver,proto3" json:"ver,omitempty"`
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Ctime int32  `protobuf:"varint,5,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Mtime int32  `protobuf:"varint,6,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Rev   int32  `protobuf:"varint,7,opt,name=rev,proto3" json:"rev,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Channel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Channel) ProtoMessage() {}
+
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Channel) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *Channel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Channel) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *Channel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Channel) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Channel) GetMtime() int32 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+func (x *Channel) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+type ChannelHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Appid   string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name    string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Ver     string `protobuf:"bytes,4,opt,name=ver,proto3" json:"ver,omitempty"`
+	PrevVer string `protobuf:"bytes,5,opt,name=prevVer,proto3" json:"prevVer,omitempty"`
+	Actor   string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	Ctime   int32  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *ChannelHistory) Reset() {
+	*x = ChannelHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHistory) ProtoMessage() {}
+
+func (x *ChannelHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHistory.ProtoReflect.Descriptor instead.
+func (*ChannelHistory) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ChannelHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetPrevVer() string {
+	if x != nil {
+		return x.PrevVer
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ChannelHistory) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type ChannelSetTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ver         string `protobuf:"bytes,3,opt,name=ver,proto3" json:"ver,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,5,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
+}
+
+func (x *ChannelSetTask) Reset() {
+	*x = ChannelSetTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelSetTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelSetTask) ProtoMessage() {}
+
+func (x *ChannelSetTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelSetTask.ProtoReflect.Descriptor instead.
+func (*ChannelSetTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ChannelSetTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *ChannelSetTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelSetTask) GetVer() string {
+	if x != nil {
+		return x.Ver
+	}
+	return ""
+}
+
+func (x *ChannelSetTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ChannelSetTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+type ChannelGetTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ChannelGetTask) Reset() {
+	*x = ChannelGetTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelGetTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelGetTask) ProtoMessage() {}
+
+func (x *ChannelGetTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelGetTask.ProtoReflect.Descriptor instead.
+func (*ChannelGetTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ChannelGetTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *ChannelGetTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ChannelRemoveTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,3,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
+}
+
+func (x *ChannelRemoveTask) Reset() {
+	*x = ChannelRemoveTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelRemoveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelRemoveTask) ProtoMessage() {}
+
+func (x *ChannelRemoveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelRemoveTask.ProtoReflect.Descriptor instead.
+func (*ChannelRemoveTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ChannelRemoveTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *ChannelRemoveTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelRemoveTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+type ChannelQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+}
+
+func (x *ChannelQueryTask) Reset() {
+	*x = ChannelQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelQueryTask) ProtoMessage() {}
+
+func (x *ChannelQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelQueryTask.ProtoReflect.Descriptor instead.
+func (*ChannelQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ChannelQueryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+type ChannelHistoryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	P     int32  `protobuf:"varint,3,opt,name=p,proto3" json:"p,omitempty"`
+	N     int32  `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *ChannelHistoryTask) Reset() {
+	*x = ChannelHistoryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelHistoryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHistoryTask) ProtoMessage() {}
+
+func (x *ChannelHistoryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHistoryTask.ProtoReflect.Descriptor instead.
+func (*ChannelHistoryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ChannelHistoryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *ChannelHistoryTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChannelHistoryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *ChannelHistoryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type ChannelResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32    `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *Channel `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ChannelResult) Reset() {
+	*x = ChannelResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelResult) ProtoMessage() {}
+
+func (x *ChannelResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelResult.ProtoReflect.Descriptor instead.
+func (*ChannelResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ChannelResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *ChannelResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *ChannelResult) GetData() *Channel {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ChannelQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32      `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string     `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Items  []*Channel `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ChannelQueryResult) Reset() {
+	*x = ChannelQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelQueryResult) ProtoMessage() {}
+
+func (x *ChannelQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelQueryResult.ProtoReflect.Descriptor instead.
+func (*ChannelQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ChannelQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *ChannelQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *ChannelQueryResult) GetItems() []*Channel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ChannelHistoryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32             `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string            `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page             `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*ChannelHistory `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ChannelHistoryResult) Reset() {
+	*x = ChannelHistoryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelHistoryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHistoryResult) ProtoMessage() {}
+
+func (x *ChannelHistoryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHistoryResult.ProtoReflect.Descriptor instead.
+func (*ChannelHistoryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ChannelHistoryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *ChannelHistoryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *ChannelHistoryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ChannelHistoryResult) GetItems() []*ChannelHistory {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RolloutWave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percent int32    `protobuf:"varint,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	Cids    []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *RolloutWave) Reset() {
+	*x = RolloutWave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutWave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutWave) ProtoMessage() {}
+
+func (x *RolloutWave) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutWave.ProtoReflect.Descriptor instead.
+func (*RolloutWave) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RolloutWave) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RolloutWave) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type Rollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Appid   string         `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	FromVer string         `protobuf:"bytes,3,opt,name=fromVer,proto3" json:"fromVer,omitempty"`
+	ToVer   string         `protobuf:"bytes,4,opt,name=toVer,proto3" json:"toVer,omitempty"`
+	Title   string         `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Status  int32          `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Wave    int32          `protobuf:"varint,7,opt,name=wave,proto3" json:"wave,omitempty"`
+	Waves   []*RolloutWave `protobuf:"bytes,8,rep,name=waves,proto3" json:"waves,omitempty"`
+	Total   int32          `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Done    int32          `protobuf:"varint,10,opt,name=done,proto3" json:"done,omitempty"`
+	Skipped int32          `protobuf:"varint,11,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed  int32          `protobuf:"varint,12,opt,name=failed,proto3" json:"failed,omitempty"`
+	Ctime   int32          `protobuf:"varint,13,opt,name=ctime,proto3" json:"ctime,omitempty"`
+	Mtime   int32          `protobuf:"varint,14,opt,name=mtime,proto3" json:"mtime,omitempty"`
+	Rev     int32          `protobuf:"varint,15,opt,name=rev,proto3" json:"rev,omitempty"`
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Rollout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Rollout) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *Rollout) GetFromVer() string {
+	if x != nil {
+		return x.FromVer
+	}
+	return ""
+}
+
+func (x *Rollout) GetToVer() string {
+	if x != nil {
+		return x.ToVer
+	}
+	return ""
+}
+
+func (x *Rollout) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Rollout) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Rollout) GetWave() int32 {
+	if x != nil {
+		return x.Wave
+	}
+	return 0
+}
+
+func (x *Rollout) GetWaves() []*RolloutWave {
+	if x != nil {
+		return x.Waves
+	}
+	return nil
+}
+
+func (x *Rollout) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Rollout) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *Rollout) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *Rollout) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *Rollout) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+func (x *Rollout) GetMtime() int32 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+func (x *Rollout) GetRev() int32 {
+	if x != nil {
+		return x.Rev
+	}
+	return 0
+}
+
+type RolloutItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RolloutId string `protobuf:"bytes,1,opt,name=rolloutId,proto3" json:"rolloutId,omitempty"`
+	Cid       string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid     string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+	Wave      int32  `protobuf:"varint,4,opt,name=wave,proto3" json:"wave,omitempty"`
+	FromVer   string `protobuf:"bytes,5,opt,name=fromVer,proto3" json:"fromVer,omitempty"`
+	Status    int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Errmsg    string `protobuf:"bytes,7,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Mtime     int32  `protobuf:"varint,8,opt,name=mtime,proto3" json:"mtime,omitempty"`
+}
+
+func (x *RolloutItem) Reset() {
+	*x = RolloutItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutItem) ProtoMessage() {}
+
+func (x *RolloutItem) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutItem.ProtoReflect.Descriptor instead.
+func (*RolloutItem) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RolloutItem) GetRolloutId() string {
+	if x != nil {
+		return x.RolloutId
+	}
+	return ""
+}
+
+func (x *RolloutItem) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *RolloutItem) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutItem) GetWave() int32 {
+	if x != nil {
+		return x.Wave
+	}
+	return 0
+}
+
+func (x *RolloutItem) GetFromVer() string {
+	if x != nil {
+		return x.FromVer
+	}
+	return ""
+}
+
+func (x *RolloutItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RolloutItem) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RolloutItem) GetMtime() int32 {
+	if x != nil {
+		return x.Mtime
+	}
+	return 0
+}
+
+type RolloutCreateTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid          string         `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	FromVer        string         `protobuf:"bytes,2,opt,name=fromVer,proto3" json:"fromVer,omitempty"`
+	ToVer          string         `protobuf:"bytes,3,opt,name=toVer,proto3" json:"toVer,omitempty"`
+	Title          string         `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Waves          []*RolloutWave `protobuf:"bytes,5,rep,name=waves,proto3" json:"waves,omitempty"`
+	IdempotencyKey string         `protobuf:"bytes,6,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *RolloutCreateTask) Reset() {
+	*x = RolloutCreateTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutCreateTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutCreateTask) ProtoMessage() {}
+
+func (x *RolloutCreateTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutCreateTask.ProtoReflect.Descriptor instead.
+func (*RolloutCreateTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RolloutCreateTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutCreateTask) GetFromVer() string {
+	if x != nil {
+		return x.FromVer
+	}
+	return ""
+}
+
+func (x *RolloutCreateTask) GetToVer() string {
+	if x != nil {
+		return x.ToVer
+	}
+	return ""
+}
+
+func (x *RolloutCreateTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RolloutCreateTask) GetWaves() []*RolloutWave {
+	if x != nil {
+		return x.Waves
+	}
+	return nil
+}
+
+func (x *RolloutCreateTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type RolloutStepTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid       string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id          string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ExpectedRev int32  `protobuf:"varint,3,opt,name=expectedRev,proto3" json:"expectedRev,omitempty"`
+}
+
+func (x *RolloutStepTask) Reset() {
+	*x = RolloutStepTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutStepTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutStepTask) ProtoMessage() {}
+
+func (x *RolloutStepTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutStepTask.ProtoReflect.Descriptor instead.
+func (*RolloutStepTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RolloutStepTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutStepTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RolloutStepTask) GetExpectedRev() int32 {
+	if x != nil {
+		return x.ExpectedRev
+	}
+	return 0
+}
+
+type RolloutPauseTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RolloutPauseTask) Reset() {
+	*x = RolloutPauseTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutPauseTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutPauseTask) ProtoMessage() {}
+
+func (x *RolloutPauseTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutPauseTask.ProtoReflect.Descriptor instead.
+func (*RolloutPauseTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RolloutPauseTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutPauseTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RolloutResumeTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RolloutResumeTask) Reset() {
+	*x = RolloutResumeTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutResumeTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutResumeTask) ProtoMessage() {}
+
+func (x *RolloutResumeTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutResumeTask.ProtoReflect.Descriptor instead.
+func (*RolloutResumeTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RolloutResumeTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutResumeTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RolloutAbortTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Revert bool   `protobuf:"varint,3,opt,name=revert,proto3" json:"revert,omitempty"`
+}
+
+func (x *RolloutAbortTask) Reset() {
+	*x = RolloutAbortTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutAbortTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutAbortTask) ProtoMessage() {}
+
+func (x *RolloutAbortTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutAbortTask.ProtoReflect.Descriptor instead.
+func (*RolloutAbortTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RolloutAbortTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutAbortTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RolloutAbortTask) GetRevert() bool {
+	if x != nil {
+		return x.Revert
+	}
+	return false
+}
+
+type RolloutGetTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id    string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RolloutGetTask) Reset() {
+	*x = RolloutGetTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutGetTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutGetTask) ProtoMessage() {}
+
+func (x *RolloutGetTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutGetTask.ProtoReflect.Descriptor instead.
+func (*RolloutGetTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RolloutGetTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutGetTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RolloutQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	P      int32  `protobuf:"varint,3,opt,name=p,proto3" json:"p,omitempty"`
+	N      int32  `protobuf:"varint,4,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *RolloutQueryTask) Reset() {
+	*x = RolloutQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutQueryTask) ProtoMessage() {}
+
+func (x *RolloutQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutQueryTask.ProtoReflect.Descriptor instead.
+func (*RolloutQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RolloutQueryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutQueryTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RolloutQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *RolloutQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type RolloutItemQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Appid  string `protobuf:"bytes,1,opt,name=appid,proto3" json:"appid,omitempty"`
+	Id     string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	P      int32  `protobuf:"varint,4,opt,name=p,proto3" json:"p,omitempty"`
+	N      int32  `protobuf:"varint,5,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *RolloutItemQueryTask) Reset() {
+	*x = RolloutItemQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutItemQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutItemQueryTask) ProtoMessage() {}
+
+func (x *RolloutItemQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutItemQueryTask.ProtoReflect.Descriptor instead.
+func (*RolloutItemQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RolloutItemQueryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RolloutItemQueryTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RolloutItemQueryTask) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RolloutItemQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *RolloutItemQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type RolloutResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32    `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *Rollout `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RolloutResult) Reset() {
+	*x = RolloutResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutResult) ProtoMessage() {}
+
+func (x *RolloutResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutResult.ProtoReflect.Descriptor instead.
+func (*RolloutResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RolloutResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RolloutResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RolloutResult) GetData() *Rollout {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RolloutQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32      `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string     `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page      `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*Rollout `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RolloutQueryResult) Reset() {
+	*x = RolloutQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutQueryResult) ProtoMessage() {}
+
+func (x *RolloutQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutQueryResult.ProtoReflect.Descriptor instead.
+func (*RolloutQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RolloutQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RolloutQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RolloutQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *RolloutQueryResult) GetItems() []*Rollout {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RolloutItemQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32          `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string         `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page          `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*RolloutItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RolloutItemQueryResult) Reset() {
+	*x = RolloutItemQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RolloutItemQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutItemQueryResult) ProtoMessage() {}
+
+func (x *RolloutItemQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutItemQueryResult.ProtoReflect.Descriptor instead.
+func (*RolloutItemQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RolloutItemQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RolloutItemQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RolloutItemQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *RolloutItemQueryResult) GetItems() []*RolloutItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type Audit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Eid    string `protobuf:"bytes,3,opt,name=eid,proto3" json:"eid,omitempty"`
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Actor  string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Trace  string `protobuf:"bytes,6,opt,name=trace,proto3" json:"trace,omitempty"`
+	Before string `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	Ctime  int32  `protobuf:"varint,9,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *Audit) Reset() {
+	*x = Audit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Audit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audit) ProtoMessage() {}
+
+func (x *Audit) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audit.ProtoReflect.Descriptor instead.
+func (*Audit) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Audit) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Audit) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Audit) GetEid() string {
+	if x != nil {
+		return x.Eid
+	}
+	return ""
+}
+
+func (x *Audit) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Audit) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *Audit) GetTrace() string {
+	if x != nil {
+		return x.Trace
+	}
+	return ""
+}
+
+func (x *Audit) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *Audit) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *Audit) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type AuditQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Eid    string `protobuf:"bytes,2,opt,name=eid,proto3" json:"eid,omitempty"`
+	Actor  string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Start  int32  `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	End    int32  `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
+	P      int32  `protobuf:"varint,7,opt,name=p,proto3" json:"p,omitempty"`
+	N      int32  `protobuf:"varint,8,opt,name=n,proto3" json:"n,omitempty"`
+	Cursor string `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  bool   `protobuf:"varint,10,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AuditQueryTask) Reset() {
+	*x = AuditQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditQueryTask) ProtoMessage() {}
+
+func (x *AuditQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditQueryTask.ProtoReflect.Descriptor instead.
+func (*AuditQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AuditQueryTask) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditQueryTask) GetEid() string {
+	if x != nil {
+		return x.Eid
+	}
+	return ""
+}
+
+func (x *AuditQueryTask) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditQueryTask) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditQueryTask) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *AuditQueryTask) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *AuditQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *AuditQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *AuditQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *AuditQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+type AuditQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32    `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string   `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page    `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*Audit `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *AuditQueryResult) Reset() {
+	*x = AuditQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditQueryResult) ProtoMessage() {}
+
+func (x *AuditQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditQueryResult.ProtoReflect.Descriptor instead.
+func (*AuditQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AuditQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *AuditQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *AuditQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *AuditQueryResult) GetItems() []*Audit {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AuditQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ManifestApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ac  *Ac               `protobuf:"bytes,1,opt,name=ac,proto3" json:"ac,omitempty"`
+	Ver *Ver              `protobuf:"bytes,2,opt,name=ver,proto3" json:"ver,omitempty"`
+	Env map[string]string `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Url string            `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *ManifestApp) Reset() {
+	*x = ManifestApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestApp) ProtoMessage() {}
+
+func (x *ManifestApp) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestApp.ProtoReflect.Descriptor instead.
+func (*ManifestApp) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ManifestApp) GetAc() *Ac {
+	if x != nil {
+		return x.Ac
+	}
+	return nil
+}
+
+func (x *ManifestApp) GetVer() *Ver {
+	if x != nil {
+		return x.Ver
+	}
+	return nil
+}
+
+func (x *ManifestApp) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ManifestApp) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Container *Container     `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Apps      []*ManifestApp `protobuf:"bytes,2,rep,name=apps,proto3" json:"apps,omitempty"`
+	Hash      string         `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Manifest) GetContainer() *Container {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *Manifest) GetApps() []*ManifestApp {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+func (x *Manifest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type ContainerManifestTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid     string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Ability string `protobuf:"bytes,2,opt,name=ability,proto3" json:"ability,omitempty"`
+	Expires int32  `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *ContainerManifestTask) Reset() {
+	*x = ContainerManifestTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerManifestTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerManifestTask) ProtoMessage() {}
+
+func (x *ContainerManifestTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerManifestTask.ProtoReflect.Descriptor instead.
+func (*ContainerManifestTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ContainerManifestTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *ContainerManifestTask) GetAbility() string {
+	if x != nil {
+		return x.Ability
+	}
+	return ""
+}
+
+func (x *ContainerManifestTask) GetExpires() int32 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+type ContainerManifestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32     `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string    `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *Manifest `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ContainerManifestResult) Reset() {
+	*x = ContainerManifestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerManifestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerManifestResult) ProtoMessage() {}
+
+func (x *ContainerManifestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerManifestResult.ProtoReflect.Descriptor instead.
+func (*ContainerManifestResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ContainerManifestResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *ContainerManifestResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *ContainerManifestResult) GetData() *Manifest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ContainerWatchTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	ResumeToken string `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *ContainerWatchTask) Reset() {
+	*x = ContainerWatchTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerWatchTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerWatchTask) ProtoMessage() {}
+
+func (x *ContainerWatchTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerWatchTask.ProtoReflect.Descriptor instead.
+func (*ContainerWatchTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ContainerWatchTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *ContainerWatchTask) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type AcWatchTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid         string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Appid       string `protobuf:"bytes,2,opt,name=appid,proto3" json:"appid,omitempty"`
+	ResumeToken string `protobuf:"bytes,3,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *AcWatchTask) Reset() {
+	*x = AcWatchTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcWatchTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcWatchTask) ProtoMessage() {}
+
+func (x *AcWatchTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcWatchTask.ProtoReflect.Descriptor instead.
+func (*AcWatchTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *AcWatchTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *AcWatchTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *AcWatchTask) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno       int32      `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg      string     `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Type        string     `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ResumeToken string     `protobuf:"bytes,4,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	Container   *Container `protobuf:"bytes,5,opt,name=container,proto3" json:"container,omitempty"`
+	Ac          *Ac        `protobuf:"bytes,6,opt,name=ac,proto3" json:"ac,omitempty"`
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *WatchEvent) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetContainer() *Container {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetAc() *Ac {
+	if x != nil {
+		return x.Ac
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Secret string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Ctime  int32  `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *User) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *User) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type UserCreateTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *UserCreateTask) Reset() {
+	*x = UserCreateTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCreateTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCreateTask) ProtoMessage() {}
+
+func (x *UserCreateTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCreateTask.ProtoReflect.Descriptor instead.
+func (*UserCreateTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UserCreateTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UserCreateTask) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type UserRemoveTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *UserRemoveTask) Reset() {
+	*x = UserRemoveTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRemoveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRemoveTask) ProtoMessage() {}
+
+func (x *UserRemoveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRemoveTask.ProtoReflect.Descriptor instead.
+func (*UserRemoveTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UserRemoveTask) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type UserQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Q          string      `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	P          int32       `protobuf:"varint,2,opt,name=p,proto3" json:"p,omitempty"`
+	N          int32       `protobuf:"varint,3,opt,name=n,proto3" json:"n,omitempty"`
+	Match      FilterMatch `protobuf:"varint,4,opt,name=match,proto3,enum=app.FilterMatch" json:"match,omitempty"`
+	IgnoreCase bool        `protobuf:"varint,5,opt,name=ignoreCase,proto3" json:"ignoreCase,omitempty"`
+	Cursor     string      `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count      bool        `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *UserQueryTask) Reset() {
+	*x = UserQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserQueryTask) ProtoMessage() {}
+
+func (x *UserQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserQueryTask.ProtoReflect.Descriptor instead.
+func (*UserQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UserQueryTask) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *UserQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *UserQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *UserQueryTask) GetMatch() FilterMatch {
+	if x != nil {
+		return x.Match
+	}
+	return FilterMatch_MATCH_CONTAINS
+}
+
+func (x *UserQueryTask) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *UserQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *UserQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+type UserResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *User  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *UserResult) Reset() {
+	*x = UserResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserResult) ProtoMessage() {}
+
+func (x *UserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserResult.ProtoReflect.Descriptor instead.
+func (*UserResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UserResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *UserResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *UserResult) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32   `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string  `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page   `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*User `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Cursor string  `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *UserQueryResult) Reset() {
+	*x = UserQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserQueryResult) ProtoMessage() {}
+
+func (x *UserQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserQueryResult.ProtoReflect.Descriptor instead.
+func (*UserQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UserQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *UserQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *UserQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *UserQueryResult) GetItems() []*User {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *UserQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type Role struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title   string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Methods []string `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	Ctime   int32    `protobuf:"varint,4,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Role) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Role) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *Role) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type RoleSetTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title   string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Methods []string `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *RoleSetTask) Reset() {
+	*x = RoleSetTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleSetTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSetTask) ProtoMessage() {}
+
+func (x *RoleSetTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSetTask.ProtoReflect.Descriptor instead.
+func (*RoleSetTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RoleSetTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleSetTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *RoleSetTask) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type RoleRemoveTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RoleRemoveTask) Reset() {
+	*x = RoleRemoveTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleRemoveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRemoveTask) ProtoMessage() {}
+
+func (x *RoleRemoveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRemoveTask.ProtoReflect.Descriptor instead.
+func (*RoleRemoveTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RoleRemoveTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RoleQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	P      int32  `protobuf:"varint,1,opt,name=p,proto3" json:"p,omitempty"`
+	N      int32  `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Count  bool   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *RoleQueryTask) Reset() {
+	*x = RoleQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleQueryTask) ProtoMessage() {}
+
+func (x *RoleQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleQueryTask.ProtoReflect.Descriptor instead.
+func (*RoleQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RoleQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *RoleQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *RoleQueryTask) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *RoleQueryTask) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+type RoleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32  `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *Role  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RoleResult) Reset() {
+	*x = RoleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleResult) ProtoMessage() {}
+
+func (x *RoleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleResult.ProtoReflect.Descriptor instead.
+func (*RoleResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *RoleResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RoleResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RoleResult) GetData() *Role {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RoleQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32   `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string  `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Items  []*Role `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Page   *Page   `protobuf:"bytes,4,opt,name=page,proto3" json:"page,omitempty"`
+	Cursor string  `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *RoleQueryResult) Reset() {
+	*x = RoleQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleQueryResult) ProtoMessage() {}
+
+func (x *RoleQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleQueryResult.ProtoReflect.Descriptor instead.
+func (*RoleQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RoleQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RoleQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RoleQueryResult) GetItems() []*Role {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RoleQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *RoleQueryResult) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type RoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid   string `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role  string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Appid string `protobuf:"bytes,4,opt,name=appid,proto3" json:"appid,omitempty"`
+	Cid   string `protobuf:"bytes,5,opt,name=cid,proto3" json:"cid,omitempty"`
+	Ctime int32  `protobuf:"varint,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RoleBinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetCtime() int32 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type RoleBindingAddTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role  string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Appid string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+	Cid   string `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *RoleBindingAddTask) Reset() {
+	*x = RoleBindingAddTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingAddTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingAddTask) ProtoMessage() {}
+
+func (x *RoleBindingAddTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingAddTask.ProtoReflect.Descriptor instead.
+func (*RoleBindingAddTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RoleBindingAddTask) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RoleBindingAddTask) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBindingAddTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RoleBindingAddTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type RoleBindingRemoveTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RoleBindingRemoveTask) Reset() {
+	*x = RoleBindingRemoveTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingRemoveTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingRemoveTask) ProtoMessage() {}
+
+func (x *RoleBindingRemoveTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingRemoveTask.ProtoReflect.Descriptor instead.
+func (*RoleBindingRemoveTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RoleBindingRemoveTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RoleBindingQueryTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Role  string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Appid string `protobuf:"bytes,3,opt,name=appid,proto3" json:"appid,omitempty"`
+	Cid   string `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	P     int32  `protobuf:"varint,5,opt,name=p,proto3" json:"p,omitempty"`
+	N     int32  `protobuf:"varint,6,opt,name=n,proto3" json:"n,omitempty"`
+}
+
+func (x *RoleBindingQueryTask) Reset() {
+	*x = RoleBindingQueryTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingQueryTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingQueryTask) ProtoMessage() {}
+
+func (x *RoleBindingQueryTask) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingQueryTask.ProtoReflect.Descriptor instead.
+func (*RoleBindingQueryTask) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RoleBindingQueryTask) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *RoleBindingQueryTask) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *RoleBindingQueryTask) GetAppid() string {
+	if x != nil {
+		return x.Appid
+	}
+	return ""
+}
+
+func (x *RoleBindingQueryTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *RoleBindingQueryTask) GetP() int32 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+func (x *RoleBindingQueryTask) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type RoleBindingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32        `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string       `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Data   *RoleBinding `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RoleBindingResult) Reset() {
+	*x = RoleBindingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingResult) ProtoMessage() {}
+
+func (x *RoleBindingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingResult.ProtoReflect.Descriptor instead.
+func (*RoleBindingResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RoleBindingResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RoleBindingResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RoleBindingResult) GetData() *RoleBinding {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RoleBindingQueryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errno  int32          `protobuf:"varint,1,opt,name=errno,proto3" json:"errno,omitempty"`
+	Errmsg string         `protobuf:"bytes,2,opt,name=errmsg,proto3" json:"errmsg,omitempty"`
+	Page   *Page          `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Items  []*RoleBinding `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RoleBindingQueryResult) Reset() {
+	*x = RoleBindingQueryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_uv_pb_app_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingQueryResult) ProtoMessage() {}
+
+func (x *RoleBindingQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_uv_pb_app_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingQueryResult.ProtoReflect.Descriptor instead.
+func (*RoleBindingQueryResult) Descriptor() ([]byte, []int) {
+	return file_uv_pb_app_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RoleBindingQueryResult) GetErrno() int32 {
+	if x != nil {
+		return x.Errno
+	}
+	return 0
+}
+
+func (x *RoleBindingQueryResult) GetErrmsg() string {
+	if x != nil {
+		return x.Errmsg
+	}
+	return ""
+}
+
+func (x *RoleBindingQueryResult) GetPage() *Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *RoleBindingQueryResult) GetItems() []*RoleBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_uv_pb_app_proto protoreflect.FileDescriptor
+
+var file_uv_pb_app_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x75, 0x76, 0x2d, 0x70, 0x62, 0x2d, 0x61, 0x70, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x03, 0x61, 0x70, 0x70, 0x22, 0x7f, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x76, 0x22, 0x7f, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x87, 0x02, 0x0a, 0x03, 0x56, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72,
+	0x65, 0x76, 0x12, 0x28, 0x0a, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x09, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x03, 0x65, 0x6e,
+	0x76, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x76, 0x1a, 0x36, 0x0a,
+	0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa2, 0x02, 0x0a, 0x02, 0x41, 0x63, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x12, 0x22, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x76, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x01, 0x0a, 0x07, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x61, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6f, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x73, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x73, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x58,
+	0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x0d, 0x49, 0x6e, 0x66, 0x6f,
+	0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x6f,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xf4, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x45, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x26, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x95, 0x01, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa1, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12,
+	0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x24,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a,
+	0x0d, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x41, 0x63, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x61, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x22, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x41, 0x70,
+	0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x9e, 0x01, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76,
+	0x22, 0x95, 0x01, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63,
+	0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x7f, 0x0a, 0x09, 0x41, 0x70, 0x70, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x0d, 0x56, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x34, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x22, 0x95, 0x02, 0x0a, 0x0c,
+	0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71,
+	0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c,
+	0x0a, 0x01, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43,
+	0x61, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x5a, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x22,
+	0xb0, 0x01, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x73, 0x65,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x76, 0x22, 0x59, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x6b, 0x0a,
+	0x0d, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0f, 0x56, 0x65,
+	0x72, 0x47, 0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x6a, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x10,
+	0x56, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x6d,
+	0x0a, 0x0d, 0x56, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x22, 0xac, 0x01,
+	0x0a, 0x08, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52,
+	0x4c, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x61, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x21, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x57, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56,
+	0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd4, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x33, 0x0a, 0x03, 0x65, 0x6e,
+	0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x24, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01,
+	0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43,
+	0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x8a, 0x02, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7d,
+	0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x8b, 0x01,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12,
+	0x22, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xb6, 0x02, 0x0a, 0x09,
+	0x41, 0x63, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a,
+	0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x41, 0x63, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x36, 0x0a, 0x08,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x33, 0x0a, 0x09, 0x41, 0x63, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0x94, 0x02, 0x0a, 0x0b, 0x41, 0x63,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12,
+	0x0c, 0x0a, 0x01, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a,
+	0x01, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x05, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x73, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xa6, 0x02, 0x0a, 0x09, 0x41, 0x63, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x29, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x2e,
+	0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x76, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0c, 0x41, 0x63, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x22, 0xb4, 0x02, 0x0a, 0x09, 0x41, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x76, 0x12, 0x10, 0x0a, 0x03, 0x76,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x65, 0x72, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x2e, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69,
+	0x6d, 0x65, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x41,
+	0x63, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x52, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x52, 0x65,
+	0x76, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x22, 0x53, 0x0a, 0x0d, 0x41, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41,
+	0x63, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x55, 0x0a, 0x08, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1b, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x76, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72,
+	0x65, 0x76, 0x22, 0xa2, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x56, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x3a,
+	0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x11, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x28, 0x0a, 0x10, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x6e, 0x22, 0x5f, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67,
+	0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3b, 0x0a, 0x0b, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0xe3, 0x02, 0x0a, 0x07, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72,
+	0x6f, 0x6d, 0x56, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f,
+	0x6d, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x76, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x05,
+	0x77, 0x61, 0x76, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x76, 0x65, 0x52, 0x05, 0x77,
+	0x61, 0x76, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f,
+	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x72, 0x65, 0x76, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x65, 0x76, 0x22, 0xc7,
+	0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d,
+	0x56, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x56,
+	0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6d, 0x73, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d,
+	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6d, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x56, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x77, 0x61,
+	0x76, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x76, 0x65, 0x52, 0x05, 0x77, 0x61, 0x76,
+	0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x59, 0x0a, 0x0f, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52,
+	0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x76, 0x22, 0x38, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x39, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x50, 0x0a, 0x10, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x22, 0x36, 0x0a, 0x0e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x70, 0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x6e, 0x22, 0x70, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x01, 0x6e, 0x22, 0x5f, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8d, 0x01,
+	0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc5, 0x01,
+	0x0a, 0x05, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x99,
+	0x01, 0x0a, 0x10, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x0b, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x12, 0x17, 0x0a, 0x02, 0x61, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52,
+	0x02, 0x61, 0x63, 0x12, 0x1a, 0x0a, 0x03, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x03, 0x76, 0x65, 0x72, 0x12,
+	0x2b, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x2e, 0x45,
+	0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x1a, 0x36,
+	0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x72, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x6a, 0x0a, 0x17, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d,
+	0x73, 0x67, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x57, 0x0a, 0x0b, 0x41, 0x63, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x02, 0x61, 0x63, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x02,
+	0x61, 0x63, 0x22, 0x5a, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x4e,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x22,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x69, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x01, 0x71, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70,
+	0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x12, 0x26,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x43, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x43, 0x61, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x59, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67,
+	0x12, 0x1d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x97, 0x01, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73,
+	0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x04, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x0b, 0x52,
+	0x6f, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x24,
+	0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x59, 0x0a, 0x0d, 0x52, 0x6f, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x59, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x97, 0x01, 0x0a, 0x0f, 0x52,
+	0x6f, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1f, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x81, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
+	0x70, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x15,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x0c, 0x0a, 0x01,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x22, 0x67, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x24, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x8d, 0x01, 0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6e, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6d, 0x73, 0x67, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x50,
+	0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2a, 0x74, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d,
+	0x0a, 0x09, 0x56, 0x45, 0x52, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54, 0x10, 0x00, 0x12, 0x10, 0x0a,
+	0x0c, 0x56, 0x45, 0x52, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x56, 0x45, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x52, 0x45,
+	0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x45, 0x52, 0x5f, 0x59,
+	0x41, 0x4e, 0x4b, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x54, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0x91, 0x17,
+	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x41, 0x70, 0x70,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70,
+	0x70, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x41,
+	0x70, 0x70, 0x53, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x53,
+	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x41, 0x70, 0x70, 0x47, 0x65, 0x74,
+	0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x32, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x11, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x53, 0x65,
+	0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x53, 0x65, 0x74, 0x54, 0x61,
+	0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x0f, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a,
+	0x08, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x31, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12,
+	0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x55, 0x52,
+	0x4c, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x47, 0x65, 0x74, 0x55, 0x52,
+	0x4c, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x47,
+	0x65, 0x74, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x56,
+	0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
+	0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x13, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x35, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12,
+	0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x55, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65, 0x72, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x56, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a,
+	0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x12, 0x15, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4d, 0x0a,
+	0x11, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x1c,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05,
+	0x41, 0x63, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x41, 0x64,
+	0x64, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x41, 0x63, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x11, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x41, 0x63, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x41, 0x63, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x41, 0x63,
+	0x47, 0x65, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x47, 0x65, 0x74, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x41, 0x63, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0d, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x17,
+	0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x07, 0x41, 0x63,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x63, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x35, 0x0a, 0x0a, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35,
+	0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x12, 0x13, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x17, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b,
+	0x1a, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x65, 0x70, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x39, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x0d,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x52, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x75, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x47,
+	0x65, 0x74, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x47, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x19, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x32, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x55, 0x73, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x10, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x0f, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x13, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x1a, 0x0f, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x12, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x70,
+	0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x64, 0x64,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x16, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a, 0x11,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x16, 0x2e,
+	0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4a, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x61, 0x73, 0x6b, 0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x07, 0x5a, 0x05, 0x2e, 0x2f, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_uv_pb_app_proto_rawDescOnce sync.Once
+	file_uv_pb_app_proto_rawDescData = file_uv_pb_app_proto_rawDesc
+)
+
+func file_uv_pb_app_proto_rawDescGZIP() []byte {
+	file_uv_pb_app_proto_rawDescOnce.Do(func() {
+		file_uv_pb_app_proto_rawDescData = protoimpl.X.CompressGZIP(file_uv_pb_app_proto_rawDescData)
+	})
+	return file_uv_pb_app_proto_rawDescData
+}
+
+var file_uv_pb_app_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_uv_pb_app_proto_msgTypes = make([]protoimpl.MessageInfo, 111)
+var file_uv_pb_app_proto_goTypes = []interface{}{
+	(VerStatus)(0),                  // 0: app.VerStatus
+	(FilterMatch)(0),                // 1: app.FilterMatch
+	(*App)(nil),                     // 2: app.App
+	(*VerTransition)(nil),           // 3: app.VerTransition
+	(*Ver)(nil),                     // 4: app.Ver
+	(*Package)(nil),                 // 5: app.Package
+	(*Container)(nil),               // 6: app.Container
+	(*Ac)(nil),                      // 7: app.Ac
+	(*Removed)(nil),                 // 8: app.Removed
+	(*Page)(nil),                    // 9: app.Page
+	(*InfoPredicate)(nil),           // 10: app.InfoPredicate
+	(*Filter)(nil),                  // 11: app.Filter
+	(*AppQueryResult)(nil),          // 12: app.AppQueryResult
+	(*VerQueryResult)(nil),          // 13: app.VerQueryResult
+	(*ContainerQueryResult)(nil),    // 14: app.ContainerQueryResult
+	(*AcQueryResult)(nil),           // 15: app.AcQueryResult
+	(*AppCreateTask)(nil),           // 16: app.AppCreateTask
+	(*AppGetTask)(nil),              // 17: app.AppGetTask
+	(*AppQueryTask)(nil),            // 18: app.AppQueryTask
+	(*AppSetTask)(nil),              // 19: app.AppSetTask
+	(*AppRemoveTask)(nil),           // 20: app.AppRemoveTask
+	(*AppResult)(nil),               // 21: app.AppResult
+	(*VerCreateTask)(nil),           // 22: app.VerCreateTask
+	(*VerGetTask)(nil),              // 23: app.VerGetTask
+	(*VerQueryTask)(nil),            // 24: app.VerQueryTask
+	(*VerResolveTask)(nil),          // 25: app.VerResolveTask
+	(*VerSetTask)(nil),              // 26: app.VerSetTask
+	(*VerRemoveTask)(nil),           // 27: app.VerRemoveTask
+	(*VerGetURLTask)(nil),           // 28: app.VerGetURLTask
+	(*VerGetURLResult)(nil),         // 29: app.VerGetURLResult
+	(*VerUpURLTask)(nil),            // 30: app.VerUpURLTask
+	(*VerUpConfirmTask)(nil),        // 31: app.VerUpConfirmTask
+	(*VerVerifyTask)(nil),           // 32: app.VerVerifyTask
+	(*VerUpURL)(nil),                // 33: app.VerUpURL
+	(*VerUpURLResult)(nil),          // 34: app.VerUpURLResult
+	(*VerResult)(nil),               // 35: app.VerResult
+	(*ContainerCreateTask)(nil),     // 36: app.ContainerCreateTask
+	(*ContainerGetTask)(nil),        // 37: app.ContainerGetTask
+	(*ContainerQueryTask)(nil),      // 38: app.ContainerQueryTask
+	(*ContainerSetTask)(nil),        // 39: app.ContainerSetTask
+	(*ContainerRemoveTask)(nil),     // 40: app.ContainerRemoveTask
+	(*ContainerResult)(nil),         // 41: app.ContainerResult
+	(*AcAddTask)(nil),               // 42: app.AcAddTask
+	(*AcGetTask)(nil),               // 43: app.AcGetTask
+	(*AcQueryTask)(nil),             // 44: app.AcQueryTask
+	(*AcSetTask)(nil),               // 45: app.AcSetTask
+	(*AcRemoveTask)(nil),            // 46: app.AcRemoveTask
+	(*AcHistory)(nil),               // 47: app.AcHistory
+	(*AcRollbackTask)(nil),          // 48: app.AcRollbackTask
+	(*AcHistoryTask)(nil),           // 49: app.AcHistoryTask
+	(*AcHistoryResult)(nil),         // 50: app.AcHistoryResult
+	(*AcResult)(nil),                // 51: app.AcResult
+	(*Channel)(nil),                 // 52: app.Channel
+	(*ChannelHistory)(nil),          // 53: app.ChannelHistory
+	(*ChannelSetTask)(nil),          // 54: app.ChannelSetTask
+	(*ChannelGetTask)(nil),          // 55: app.ChannelGetTask
+	(*ChannelRemoveTask)(nil),       // 56: app.ChannelRemoveTask
+	(*ChannelQueryTask)(nil),        // 57: app.ChannelQueryTask
+	(*ChannelHistoryTask)(nil),      // 58: app.ChannelHistoryTask
+	(*ChannelResult)(nil),           // 59: app.ChannelResult
+	(*ChannelQueryResult)(nil),      // 60: app.ChannelQueryResult
+	(*ChannelHistoryResult)(nil),    // 61: app.ChannelHistoryResult
+	(*RolloutWave)(nil),             // 62: app.RolloutWave
+	(*Rollout)(nil),                 // 63: app.Rollout
+	(*RolloutItem)(nil),             // 64: app.RolloutItem
+	(*RolloutCreateTask)(nil),       // 65: app.RolloutCreateTask
+	(*RolloutStepTask)(nil),         // 66: app.RolloutStepTask
+	(*RolloutPauseTask)(nil),        // 67: app.RolloutPauseTask
+	(*RolloutResumeTask)(nil),       // 68: app.RolloutResumeTask
+	(*RolloutAbortTask)(nil),        // 69: app.RolloutAbortTask
+	(*RolloutGetTask)(nil),          // 70: app.RolloutGetTask
+	(*RolloutQueryTask)(nil),        // 71: app.RolloutQueryTask
+	(*RolloutItemQueryTask)(nil),    // 72: app.RolloutItemQueryTask
+	(*RolloutResult)(nil),           // 73: app.RolloutResult
+	(*RolloutQueryResult)(nil),      // 74: app.RolloutQueryResult
+	(*RolloutItemQueryResult)(nil),  // 75: app.RolloutItemQueryResult
+	(*Audit)(nil),                   // 76: app.Audit
+	(*AuditQueryTask)(nil),          // 77: app.AuditQueryTask
+	(*AuditQueryResult)(nil),        // 78: app.AuditQueryResult
+	(*ManifestApp)(nil),             // 79: app.ManifestApp
+	(*Manifest)(nil),                // 80: app.Manifest
+	(*ContainerManifestTask)(nil),   // 81: app.ContainerManifestTask
+	(*ContainerManifestResult)(nil), // 82: app.ContainerManifestResult
+	(*ContainerWatchTask)(nil),      // 83: app.ContainerWatchTask
+	(*AcWatchTask)(nil),             // 84: app.AcWatchTask
+	(*WatchEvent)(nil),              // 85: app.WatchEvent
+	(*User)(nil),                    // 86: app.User
+	(*UserCreateTask)(nil),          // 87: app.UserCreateTask
+	(*UserRemoveTask)(nil),          // 88: app.UserRemoveTask
+	(*UserQueryTask)(nil),           // 89: app.UserQueryTask
+	(*UserResult)(nil),              // 90: app.UserResult
+	(*UserQueryResult)(nil),         // 91: app.UserQueryResult
+	(*Role)(nil),                    // 92: app.Role
+	(*RoleSetTask)(nil),             // 93: app.RoleSetTask
+	(*RoleRemoveTask)(nil),          // 94: app.RoleRemoveTask
+	(*RoleQueryTask)(nil),           // 95: app.RoleQueryTask
+	(*RoleResult)(nil),              // 96: app.RoleResult
+	(*RoleQueryResult)(nil),         // 97: app.RoleQueryResult
+	(*RoleBinding)(nil),             // 98: app.RoleBinding
+	(*RoleBindingAddTask)(nil),      // 99: app.RoleBindingAddTask
+	(*RoleBindingRemoveTask)(nil),   // 100: app.RoleBindingRemoveTask
+	(*RoleBindingQueryTask)(nil),    // 101: app.RoleBindingQueryTask
+	(*RoleBindingResult)(nil),       // 102: app.RoleBindingResult
+	(*RoleBindingQueryResult)(nil),  // 103: app.RoleBindingQueryResult
+	nil,                             // 104: app.Container.EnvEntry
+	nil,                             // 105: app.Ac.EnvEntry
+	nil,                             // 106: app.VerUpURL.DataEntry
+	nil,                             // 107: app.ContainerCreateTask.EnvEntry
+	nil,                             // 108: app.ContainerSetTask.EnvEntry
+	nil,                             // 109: app.AcAddTask.EnvEntry
+	nil,                             // 110: app.AcSetTask.EnvEntry
+	nil,                             // 111: app.AcHistory.EnvEntry
+	nil,                             // 112: app.ManifestApp.EnvEntry
+}
+var file_uv_pb_app_proto_depIdxs = []int32{
+	0,   // 0: app.VerTransition.from:type_name -> app.VerStatus
+	0,   // 1: app.VerTransition.to:type_name -> app.VerStatus
+	0,   // 2: app.Ver.status:type_name -> app.VerStatus
+	5,   // 3: app.Ver.packages:type_name -> app.Package
+	3,   // 4: app.Ver.transitions:type_name -> app.VerTransition
+	104, // 5: app.Container.env:type_name -> app.Container.EnvEntry
+	105, // 6: app.Ac.env:type_name -> app.Ac.EnvEntry
+	1,   // 7: app.Filter.match:type_name -> app.FilterMatch
+	10,  // 8: app.Filter.info:type_name -> app.InfoPredicate
+	9,   // 9: app.AppQueryResult.page:type_name -> app.Page
+	2,   // 10: app.AppQueryResult.items:type_name -> app.App
+	9,   // 11: app.VerQueryResult.page:type_name -> app.Page
+	4,   // 12: app.VerQueryResult.items:type_name -> app.Ver
+	9,   // 13: app.ContainerQueryResult.page:type_name -> app.Page
+	6,   // 14: app.ContainerQueryResult.items:type_name -> app.Container
+	9,   // 15: app.AcQueryResult.page:type_name -> app.Page
+	7,   // 16: app.AcQueryResult.items:type_name -> app.Ac
+	11,  // 17: app.AppQueryTask.filter:type_name -> app.Filter
+	1,   // 18: app.AppQueryTask.match:type_name -> app.FilterMatch
+	2,   // 19: app.AppResult.data:type_name -> app.App
+	8,   // 20: app.AppResult.removed:type_name -> app.Removed
+	0,   // 21: app.VerCreateTask.status:type_name -> app.VerStatus
+	11,  // 22: app.VerQueryTask.filter:type_name -> app.Filter
+	1,   // 23: app.VerQueryTask.match:type_name -> app.FilterMatch
+	106, // 24: app.VerUpURL.data:type_name -> app.VerUpURL.DataEntry
+	33,  // 25: app.VerUpURLResult.data:type_name -> app.VerUpURL
+	4,   // 26: app.VerResult.data:type_name -> app.Ver
+	107, // 27: app.ContainerCreateTask.env:type_name -> app.ContainerCreateTask.EnvEntry
+	11,  // 28: app.ContainerQueryTask.filter:type_name -> app.Filter
+	1,   // 29: app.ContainerQueryTask.match:type_name -> app.FilterMatch
+	108, // 30: app.ContainerSetTask.env:type_name -> app.ContainerSetTask.EnvEntry
+	6,   // 31: app.ContainerResult.data:type_name -> app.Container
+	8,   // 32: app.ContainerResult.removed:type_name -> app.Removed
+	109, // 33: app.AcAddTask.env:type_name -> app.AcAddTask.EnvEntry
+	11,  // 34: app.AcQueryTask.filter:type_name -> app.Filter
+	1,   // 35: app.AcQueryTask.match:type_name -> app.FilterMatch
+	110, // 36: app.AcSetTask.env:type_name -> app.AcSetTask.EnvEntry
+	111, // 37: app.AcHistory.env:type_name -> app.AcHistory.EnvEntry
+	9,   // 38: app.AcHistoryResult.page:type_name -> app.Page
+	47,  // 39: app.AcHistoryResult.items:type_name -> app.AcHistory
+	7,   // 40: app.AcResult.data:type_name -> app.Ac
+	52,  // 41: app.ChannelResult.data:type_name -> app.Channel
+	52,  // 42: app.ChannelQueryResult.items:type_name -> app.Channel
+	9,   // 43: app.ChannelHistoryResult.page:type_name -> app.Page
+	53,  // 44: app.ChannelHistoryResult.items:type_name -> app.ChannelHistory
+	62,  // 45: app.Rollout.waves:type_name -> app.RolloutWave
+	62,  // 46: app.RolloutCreateTask.waves:type_name -> app.RolloutWave
+	63,  // 47: app.RolloutResult.data:type_name -> app.Rollout
+	9,   // 48: app.RolloutQueryResult.page:type_name -> app.Page
+	63,  // 49: app.RolloutQueryResult.items:type_name -> app.Rollout
+	9,   // 50: app.RolloutItemQueryResult.page:type_name -> app.Page
+	64,  // 51: app.RolloutItemQueryResult.items:type_name -> app.RolloutItem
+	9,   // 52: app.AuditQueryResult.page:type_name -> app.Page
+	76,  // 53: app.AuditQueryResult.items:type_name -> app.Audit
+	7,   // 54: app.ManifestApp.ac:type_name -> app.Ac
+	4,   // 55: app.ManifestApp.ver:type_name -> app.Ver
+	112, // 56: app.ManifestApp.env:type_name -> app.ManifestApp.EnvEntry
+	6,   // 57: app.Manifest.container:type_name -> app.Container
+	79,  // 58: app.Manifest.apps:type_name -> app.ManifestApp
+	80,  // 59: app.ContainerManifestResult.data:type_name -> app.Manifest
+	6,   // 60: app.WatchEvent.container:type_name -> app.Container
+	7,   // 61: app.WatchEvent.ac:type_name -> app.Ac
+	1,   // 62: app.UserQueryTask.match:type_name -> app.FilterMatch
+	86,  // 63: app.UserResult.data:type_name -> app.User
+	9,   // 64: app.UserQueryResult.page:type_name -> app.Page
+	86,  // 65: app.UserQueryResult.items:type_name -> app.User
+	92,  // 66: app.RoleResult.data:type_name -> app.Role
+	92,  // 67: app.RoleQueryResult.items:type_name -> app.Role
+	9,   // 68: app.RoleQueryResult.page:type_name -> app.Page
+	98,  // 69: app.RoleBindingResult.data:type_name -> app.RoleBinding
+	9,   // 70: app.RoleBindingQueryResult.page:type_name -> app.Page
+	98,  // 71: app.RoleBindingQueryResult.items:type_name -> app.RoleBinding
+	16,  // 72: app.Service.AppCreate:input_type -> app.AppCreateTask
+	20,  // 73: app.Service.AppRemove:input_type -> app.AppRemoveTask
+	19,  // 74: app.Service.AppSet:input_type -> app.AppSetTask
+	17,  // 75: app.Service.AppGet:input_type -> app.AppGetTask
+	18,  // 76: app.Service.AppQuery:input_type -> app.AppQueryTask
+	22,  // 77: app.Service.VerCreate:input_type -> app.VerCreateTask
+	27,  // 78: app.Service.VerRemove:input_type -> app.VerRemoveTask
+	26,  // 79: app.Service.VerSet:input_type -> app.VerSetTask
+	23,  // 80: app.Service.VerGet:input_type -> app.VerGetTask
+	24,  // 81: app.Service.VerQuery:input_type -> app.VerQueryTask
+	25,  // 82: app.Service.VerResolve:input_type -> app.VerResolveTask
+	28,  // 83: app.Service.VerGetURL:input_type -> app.VerGetURLTask
+	30,  // 84: app.Service.VerUpURL:input_type -> app.VerUpURLTask
+	31,  // 85: app.Service.VerUpConfirm:input_type -> app.VerUpConfirmTask
+	32,  // 86: app.Service.VerVerify:input_type -> app.VerVerifyTask
+	36,  // 87: app.Service.ContainerCreate:input_type -> app.ContainerCreateTask
+	40,  // 88: app.Service.ContainerRemove:input_type -> app.ContainerRemoveTask
+	39,  // 89: app.Service.ContainerSet:input_type -> app.ContainerSetTask
+	37,  // 90: app.Service.ContainerGet:input_type -> app.ContainerGetTask
+	38,  // 91: app.Service.ContainerQuery:input_type -> app.ContainerQueryTask
+	81,  // 92: app.Service.ContainerManifest:input_type -> app.ContainerManifestTask
+	42,  // 93: app.Service.AcAdd:input_type -> app.AcAddTask
+	46,  // 94: app.Service.AcRemove:input_type -> app.AcRemoveTask
+	45,  // 95: app.Service.AcSet:input_type -> app.AcSetTask
+	43,  // 96: app.Service.AcGet:input_type -> app.AcGetTask
+	44,  // 97: app.Service.AcQuery:input_type -> app.AcQueryTask
+	48,  // 98: app.Service.AcRollback:input_type -> app.AcRollbackTask
+	49,  // 99: app.Service.AcHistory:input_type -> app.AcHistoryTask
+	83,  // 100: app.Service.ContainerWatch:input_type -> app.ContainerWatchTask
+	84,  // 101: app.Service.AcWatch:input_type -> app.AcWatchTask
+	54,  // 102: app.Service.ChannelSet:input_type -> app.ChannelSetTask
+	56,  // 103: app.Service.ChannelRemove:input_type -> app.ChannelRemoveTask
+	55,  // 104: app.Service.ChannelGet:input_type -> app.ChannelGetTask
+	57,  // 105: app.Service.ChannelQuery:input_type -> app.ChannelQueryTask
+	58,  // 106: app.Service.ChannelHistory:input_type -> app.ChannelHistoryTask
+	65,  // 107: app.Service.RolloutCreate:input_type -> app.RolloutCreateTask
+	66,  // 108: app.Service.RolloutStep:input_type -> app.RolloutStepTask
+	67,  // 109: app.Service.RolloutPause:input_type -> app.RolloutPauseTask
+	68,  // 110: app.Service.RolloutResume:input_type -> app.RolloutResumeTask
+	69,  // 111: app.Service.RolloutAbort:input_type -> app.RolloutAbortTask
+	70,  // 112: app.Service.RolloutGet:input_type -> app.RolloutGetTask
+	71,  // 113: app.Service.RolloutQuery:input_type -> app.RolloutQueryTask
+	72,  // 114: app.Service.RolloutItemQuery:input_type -> app.RolloutItemQueryTask
+	77,  // 115: app.Service.AuditQuery:input_type -> app.AuditQueryTask
+	87,  // 116: app.Service.UserCreate:input_type -> app.UserCreateTask
+	88,  // 117: app.Service.UserRemove:input_type -> app.UserRemoveTask
+	89,  // 118: app.Service.UserQuery:input_type -> app.UserQueryTask
+	93,  // 119: app.Service.RoleSet:input_type -> app.RoleSetTask
+	94,  // 120: app.Service.RoleRemove:input_type -> app.RoleRemoveTask
+	95,  // 121: app.Service.RoleQuery:input_type -> app.RoleQueryTask
+	99,  // 122: app.Service.RoleBindingAdd:input_type -> app.RoleBindingAddTask
+	100, // 123: app.Service.RoleBindingRemove:input_type -> app.RoleBindingRemoveTask
+	101, // 124: app.Service.RoleBindingQuery:input_type -> app.RoleBindingQueryTask
+	21,  // 125: app.Service.AppCreate:output_type -> app.AppResult
+	21,  // 126: app.Service.AppRemove:output_type -> app.AppResult
+	21,  // 127: app.Service.AppSet:output_type -> app.AppResult
+	21,  // 128: app.Service.AppGet:output_type -> app.AppResult
+	12,  // 129: app.Service.AppQuery:output_type -> app.AppQueryResult
+	35,  // 130: app.Service.VerCreate:output_type -> app.VerResult
+	35,  // 131: app.Service.VerRemove:output_type -> app.VerResult
+	35,  // 132: app.Service.VerSet:output_type -> app.VerResult
+	35,  // 133: app.Service.VerGet:output_type -> app.VerResult
+	13,  // 134: app.Service.VerQuery:output_type -> app.VerQueryResult
+	35,  // 135: app.Service.VerResolve:output_type -> app.VerResult
+	29,  // 136: app.Service.VerGetURL:output_type -> app.VerGetURLResult
+	34,  // 137: app.Service.VerUpURL:output_type -> app.VerUpURLResult
+	35,  // 138: app.Service.VerUpConfirm:output_type -> app.VerResult
+	35,  // 139: app.Service.VerVerify:output_type -> app.VerResult
+	41,  // 140: app.Service.ContainerCreate:output_type -> app.ContainerResult
+	41,  // 141: app.Service.ContainerRemove:output_type -> app.ContainerResult
+	41,  // 142: app.Service.ContainerSet:output_type -> app.ContainerResult
+	41,  // 143: app.Service.ContainerGet:output_type -> app.ContainerResult
+	14,  // 144: app.Service.ContainerQuery:output_type -> app.ContainerQueryResult
+	82,  // 145: app.Service.ContainerManifest:output_type -> app.ContainerManifestResult
+	51,  // 146: app.Service.AcAdd:output_type -> app.AcResult
+	51,  // 147: app.Service.AcRemove:output_type -> app.AcResult
+	51,  // 148: app.Service.AcSet:output_type -> app.AcResult
+	51,  // 149: app.Service.AcGet:output_type -> app.AcResult
+	15,  // 150: app.Service.AcQuery:output_type -> app.AcQueryResult
+	51,  // 151: app.Service.AcRollback:output_type -> app.AcResult
+	50,  // 152: app.Service.AcHistory:output_type -> app.AcHistoryResult
+	85,  // 153: app.Service.ContainerWatch:output_type -> app.WatchEvent
+	85,  // 154: app.Service.AcWatch:output_type -> app.WatchEvent
+	59,  // 155: app.Service.ChannelSet:output_type -> app.ChannelResult
+	59,  // 156: app.Service.ChannelRemove:output_type -> app.ChannelResult
+	59,  // 157: app.Service.ChannelGet:output_type -> app.ChannelResult
+	60,  // 158: app.Service.ChannelQuery:output_type -> app.ChannelQueryResult
+	61,  // 159: app.Service.ChannelHistory:output_type -> app.ChannelHistoryResult
+	73,  // 160: app.Service.RolloutCreate:output_type -> app.RolloutResult
+	73,  // 161: app.Service.RolloutStep:output_type -> app.RolloutResult
+	73,  // 162: app.Service.RolloutPause:output_type -> app.RolloutResult
+	73,  // 163: app.Service.RolloutResume:output_type -> app.RolloutResult
+	73,  // 164: app.Service.RolloutAbort:output_type -> app.RolloutResult
+	73,  // 165: app.Service.RolloutGet:output_type -> app.RolloutResult
+	74,  // 166: app.Service.RolloutQuery:output_type -> app.RolloutQueryResult
+	75,  // 167: app.Service.RolloutItemQuery:output_type -> app.RolloutItemQueryResult
+	78,  // 168: app.Service.AuditQuery:output_type -> app.AuditQueryResult
+	90,  // 169: app.Service.UserCreate:output_type -> app.UserResult
+	90,  // 170: app.Service.UserRemove:output_type -> app.UserResult
+	91,  // 171: app.Service.UserQuery:output_type -> app.UserQueryResult
+	96,  // 172: app.Service.RoleSet:output_type -> app.RoleResult
+	96,  // 173: app.Service.RoleRemove:output_type -> app.RoleResult
+	97,  // 174: app.Service.RoleQuery:output_type -> app.RoleQueryResult
+	102, // 175: app.Service.RoleBindingAdd:output_type -> app.RoleBindingResult
+	102, // 176: app.Service.RoleBindingRemove:output_type -> app.RoleBindingResult
+	103, // 177: app.Service.RoleBindingQuery:output_type -> app.RoleBindingQueryResult
+	125, // [125:178] is the sub-list for method output_type
+	72,  // [72:125] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_uv_pb_app_proto_init() }
+func file_uv_pb_app_proto_init() {
+	if File_uv_pb_app_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_uv_pb_app_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*App); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerTransition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Package); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Container); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ac); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Removed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Page); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InfoPredicate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppCreateTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppGetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppSetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerCreateTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerGetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerResolveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerSetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerGetURLTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerGetURLResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerUpURLTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerUpConfirmTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerVerifyTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerUpURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerUpURLResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCreateTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerGetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerSetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcAddTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcGetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcSetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcRollbackTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcHistoryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcHistoryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelSetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelGetTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelHistoryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelQueryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelHistoryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutWave); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3103,8 +10079,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ver); i {
+		file_uv_pb_app_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollout); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3115,8 +10091,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Container); i {
+		file_uv_pb_app_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3127,8 +10103,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ac); i {
+		file_uv_pb_app_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutCreateTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3139,8 +10115,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Page); i {
+		file_uv_pb_app_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutStepTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3151,8 +10127,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppQueryResult); i {
+		file_uv_pb_app_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutPauseTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3163,8 +10139,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerQueryResult); i {
+		file_uv_pb_app_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutResumeTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3175,8 +10151,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerQueryResult); i {
+		file_uv_pb_app_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutAbortTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3187,8 +10163,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcQueryResult); i {
+		file_uv_pb_app_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutGetTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3199,8 +10175,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppCreateTask); i {
+		file_uv_pb_app_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutQueryTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3211,8 +10187,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppGetTask); i {
+		file_uv_pb_app_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutItemQueryTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3223,8 +10199,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppQueryTask); i {
+		file_uv_pb_app_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3235,8 +10211,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppSetTask); i {
+		file_uv_pb_app_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3247,8 +10223,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppRemoveTask); i {
+		file_uv_pb_app_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RolloutItemQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3259,8 +10235,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppResult); i {
+		file_uv_pb_app_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Audit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3271,8 +10247,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerCreateTask); i {
+		file_uv_pb_app_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditQueryTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3283,8 +10259,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerGetTask); i {
+		file_uv_pb_app_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,8 +10271,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerQueryTask); i {
+		file_uv_pb_app_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestApp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,8 +10283,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerSetTask); i {
+		file_uv_pb_app_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Manifest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3319,8 +10295,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerRemoveTask); i {
+		file_uv_pb_app_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerManifestTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3331,8 +10307,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerGetURLTask); i {
+		file_uv_pb_app_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerManifestResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3343,8 +10319,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerGetURLResult); i {
+		file_uv_pb_app_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerWatchTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3355,8 +10331,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerUpURLTask); i {
+		file_uv_pb_app_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcWatchTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3367,8 +10343,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerUpURL); i {
+		file_uv_pb_app_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3379,8 +10355,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerUpURLResult); i {
+		file_uv_pb_app_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3391,8 +10367,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerResult); i {
+		file_uv_pb_app_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCreateTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3403,8 +10379,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerCreateTask); i {
+		file_uv_pb_app_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRemoveTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3415,8 +10391,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerGetTask); i {
+		file_uv_pb_app_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserQueryTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3427,8 +10403,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerQueryTask); i {
+		file_uv_pb_app_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3439,8 +10415,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerSetTask); i {
+		file_uv_pb_app_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3451,8 +10427,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerRemoveTask); i {
+		file_uv_pb_app_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3463,8 +10439,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerResult); i {
+		file_uv_pb_app_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleSetTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3475,8 +10451,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcAddTask); i {
+		file_uv_pb_app_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRemoveTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3487,8 +10463,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcGetTask); i {
+		file_uv_pb_app_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleQueryTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3499,8 +10475,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcQueryTask); i {
+		file_uv_pb_app_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3511,8 +10487,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcSetTask); i {
+		file_uv_pb_app_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3523,8 +10499,8 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcRemoveTask); i {
+		file_uv_pb_app_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3535,8 +10511,56 @@ func file_uv_pb_app_proto_init() {
 				return nil
 			}
 		}
-		file_uv_pb_app_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcResult); i {
+		file_uv_pb_app_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingAddTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingRemoveTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingQueryTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_uv_pb_app_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingQueryResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3553,13 +10577,14 @@ func file_uv_pb_app_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_uv_pb_app_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   45,
+			NumEnums:      2,
+			NumMessages:   111,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_uv_pb_app_proto_goTypes,
 		DependencyIndexes: file_uv_pb_app_proto_depIdxs,
+		EnumInfos:         file_uv_pb_app_proto_enumTypes,
 		MessageInfos:      file_uv_pb_app_proto_msgTypes,
 	}.Build()
 	File_uv_pb_app_proto = out.File
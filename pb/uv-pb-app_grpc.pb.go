@@ -53,12 +53,21 @@ type ServiceClient interface {
 	// 查询多个应用版本
 	VerQuery(ctx context.Context, in *VerQueryTask, opts ...grpc.CallOption) (*VerQueryResult, error)
 	//*
+	// 获取满足版本范围的最高版本
+	VerResolve(ctx context.Context, in *VerResolveTask, opts ...grpc.CallOption) (*VerResult, error)
+	//*
 	// 获取应用包URL
 	VerGetURL(ctx context.Context, in *VerGetURLTask, opts ...grpc.CallOption) (*VerGetURLResult, error)
 	//*
 	// 获取应用包上传URL
 	VerUpURL(ctx context.Context, in *VerUpURLTask, opts ...grpc.CallOption) (*VerUpURLResult, error)
 	//*
+	// 确认应用包已上传
+	VerUpConfirm(ctx context.Context, in *VerUpConfirmTask, opts ...grpc.CallOption) (*VerResult, error)
+	//*
+	// 校验应用包并提取清单至 info
+	VerVerify(ctx context.Context, in *VerVerifyTask, opts ...grpc.CallOption) (*VerResult, error)
+	//*
 	// 创建容器
 	ContainerCreate(ctx context.Context, in *ContainerCreateTask, opts ...grpc.CallOption) (*ContainerResult, error)
 	//*
@@ -74,6 +83,9 @@ type ServiceClient interface {
 	// 查询多个应用版本
 	ContainerQuery(ctx context.Context, in *ContainerQueryTask, opts ...grpc.CallOption) (*ContainerQueryResult, error)
 	//*
+	// 获取容器启动清单
+	ContainerManifest(ctx context.Context, in *ContainerManifestTask, opts ...grpc.CallOption) (*ContainerManifestResult, error)
+	//*
 	// 容器添加应用
 	AcAdd(ctx context.Context, in *AcAddTask, opts ...grpc.CallOption) (*AcResult, error)
 	//*
@@ -88,6 +100,88 @@ type ServiceClient interface {
 	//*
 	// 查询多个容器应用
 	AcQuery(ctx context.Context, in *AcQueryTask, opts ...grpc.CallOption) (*AcQueryResult, error)
+	//*
+	// 回滚容器应用到历史状态
+	AcRollback(ctx context.Context, in *AcRollbackTask, opts ...grpc.CallOption) (*AcResult, error)
+	//*
+	// 查询容器应用的变更记录
+	AcHistory(ctx context.Context, in *AcHistoryTask, opts ...grpc.CallOption) (*AcHistoryResult, error)
+	//*
+	// 监听容器及容器应用变更
+	ContainerWatch(ctx context.Context, in *ContainerWatchTask, opts ...grpc.CallOption) (Service_ContainerWatchClient, error)
+	//*
+	// 监听容器应用变更
+	AcWatch(ctx context.Context, in *AcWatchTask, opts ...grpc.CallOption) (Service_AcWatchClient, error)
+	//*
+	// 创建或修改发布通道，跟随通道的容器应用同步切换版本
+	ChannelSet(ctx context.Context, in *ChannelSetTask, opts ...grpc.CallOption) (*ChannelResult, error)
+	//*
+	// 删除发布通道
+	ChannelRemove(ctx context.Context, in *ChannelRemoveTask, opts ...grpc.CallOption) (*ChannelResult, error)
+	//*
+	// 获取发布通道
+	ChannelGet(ctx context.Context, in *ChannelGetTask, opts ...grpc.CallOption) (*ChannelResult, error)
+	//*
+	// 查询应用的全部发布通道
+	ChannelQuery(ctx context.Context, in *ChannelQueryTask, opts ...grpc.CallOption) (*ChannelQueryResult, error)
+	//*
+	// 查询发布通道的推送记录
+	ChannelHistory(ctx context.Context, in *ChannelHistoryTask, opts ...grpc.CallOption) (*ChannelHistoryResult, error)
+	//*
+	// 创建灰度发布，按批次将容器应用从旧版本切换到新版本
+	RolloutCreate(ctx context.Context, in *RolloutCreateTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 执行灰度发布的当前批次，并重试之前批次中待执行及失败的容器应用
+	// 批次内全部完成或跳过后进入下一批次
+	RolloutStep(ctx context.Context, in *RolloutStepTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 暂停灰度发布
+	RolloutPause(ctx context.Context, in *RolloutPauseTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 恢复灰度发布
+	RolloutResume(ctx context.Context, in *RolloutResumeTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 终止灰度发布，可选回退已切换的容器
+	RolloutAbort(ctx context.Context, in *RolloutAbortTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 获取灰度发布
+	RolloutGet(ctx context.Context, in *RolloutGetTask, opts ...grpc.CallOption) (*RolloutResult, error)
+	//*
+	// 查询应用的灰度发布
+	RolloutQuery(ctx context.Context, in *RolloutQueryTask, opts ...grpc.CallOption) (*RolloutQueryResult, error)
+	//*
+	// 查询灰度发布中各容器的进度
+	RolloutItemQuery(ctx context.Context, in *RolloutItemQueryTask, opts ...grpc.CallOption) (*RolloutItemQueryResult, error)
+	//*
+	// 查询审计日志
+	AuditQuery(ctx context.Context, in *AuditQueryTask, opts ...grpc.CallOption) (*AuditQueryResult, error)
+	//*
+	// 创建用户
+	UserCreate(ctx context.Context, in *UserCreateTask, opts ...grpc.CallOption) (*UserResult, error)
+	//*
+	// 删除用户
+	UserRemove(ctx context.Context, in *UserRemoveTask, opts ...grpc.CallOption) (*UserResult, error)
+	//*
+	// 查询多个用户
+	UserQuery(ctx context.Context, in *UserQueryTask, opts ...grpc.CallOption) (*UserQueryResult, error)
+	//*
+	// 创建或修改角色
+	RoleSet(ctx context.Context, in *RoleSetTask, opts ...grpc.CallOption) (*RoleResult, error)
+	//*
+	// 删除角色
+	RoleRemove(ctx context.Context, in *RoleRemoveTask, opts ...grpc.CallOption) (*RoleResult, error)
+	//*
+	// 查询全部角色
+	RoleQuery(ctx context.Context, in *RoleQueryTask, opts ...grpc.CallOption) (*RoleQueryResult, error)
+	//*
+	// 用户绑定角色
+	RoleBindingAdd(ctx context.Context, in *RoleBindingAddTask, opts ...grpc.CallOption) (*RoleBindingResult, error)
+	//*
+	// 用户解绑角色
+	RoleBindingRemove(ctx context.Context, in *RoleBindingRemoveTask, opts ...grpc.CallOption) (*RoleBindingResult, error)
+	//*
+	// 查询角色绑定
+	RoleBindingQuery(ctx context.Context, in *RoleBindingQueryTask, opts ...grpc.CallOption) (*RoleBindingQueryResult, error)
 }
 
 type serviceClient struct {
@@ -188,6 +282,15 @@ func (c *serviceClient) VerQuery(ctx context.Context, in *VerQueryTask, opts ...
 	return out, nil
 }
 
+func (c *serviceClient) VerResolve(ctx context.Context, in *VerResolveTask, opts ...grpc.CallOption) (*VerResult, error) {
+	out := new(VerResult)
+	err := c.cc.Invoke(ctx, "/app.Service/VerResolve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) VerGetURL(ctx context.Context, in *VerGetURLTask, opts ...grpc.CallOption) (*VerGetURLResult, error) {
 	out := new(VerGetURLResult)
 	err := c.cc.Invoke(ctx, "/app.Service/VerGetURL", in, out, opts...)
@@ -206,6 +309,24 @@ func (c *serviceClient) VerUpURL(ctx context.Context, in *VerUpURLTask, opts ...
 	return out, nil
 }
 
+func (c *serviceClient) VerUpConfirm(ctx context.Context, in *VerUpConfirmTask, opts ...grpc.CallOption) (*VerResult, error) {
+	out := new(VerResult)
+	err := c.cc.Invoke(ctx, "/app.Service/VerUpConfirm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) VerVerify(ctx context.Context, in *VerVerifyTask, opts ...grpc.CallOption) (*VerResult, error) {
+	out := new(VerResult)
+	err := c.cc.Invoke(ctx, "/app.Service/VerVerify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) ContainerCreate(ctx context.Context, in *ContainerCreateTask, opts ...grpc.CallOption) (*ContainerResult, error) {
 	out := new(ContainerResult)
 	err := c.cc.Invoke(ctx, "/app.Service/ContainerCreate", in, out, opts...)
@@ -251,6 +372,15 @@ func (c *serviceClient) ContainerQuery(ctx context.Context, in *ContainerQueryTa
 	return out, nil
 }
 
+func (c *serviceClient) ContainerManifest(ctx context.Context, in *ContainerManifestTask, opts ...grpc.CallOption) (*ContainerManifestResult, error) {
+	out := new(ContainerManifestResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ContainerManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) AcAdd(ctx context.Context, in *AcAddTask, opts ...grpc.CallOption) (*AcResult, error) {
 	out := new(AcResult)
 	err := c.cc.Invoke(ctx, "/app.Service/AcAdd", in, out, opts...)
@@ -296,6 +426,295 @@ func (c *serviceClient) AcQuery(ctx context.Context, in *AcQueryTask, opts ...gr
 	return out, nil
 }
 
+func (c *serviceClient) AcRollback(ctx context.Context, in *AcRollbackTask, opts ...grpc.CallOption) (*AcResult, error) {
+	out := new(AcResult)
+	err := c.cc.Invoke(ctx, "/app.Service/AcRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AcHistory(ctx context.Context, in *AcHistoryTask, opts ...grpc.CallOption) (*AcHistoryResult, error) {
+	out := new(AcHistoryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/AcHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ContainerWatch(ctx context.Context, in *ContainerWatchTask, opts ...grpc.CallOption) (Service_ContainerWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[0], "/app.Service/ContainerWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceContainerWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_ContainerWatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type serviceContainerWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceContainerWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) AcWatch(ctx context.Context, in *AcWatchTask, opts ...grpc.CallOption) (Service_AcWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Service_ServiceDesc.Streams[1], "/app.Service/AcWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serviceAcWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Service_AcWatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type serviceAcWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *serviceAcWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serviceClient) ChannelSet(ctx context.Context, in *ChannelSetTask, opts ...grpc.CallOption) (*ChannelResult, error) {
+	out := new(ChannelResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ChannelSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ChannelRemove(ctx context.Context, in *ChannelRemoveTask, opts ...grpc.CallOption) (*ChannelResult, error) {
+	out := new(ChannelResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ChannelRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ChannelGet(ctx context.Context, in *ChannelGetTask, opts ...grpc.CallOption) (*ChannelResult, error) {
+	out := new(ChannelResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ChannelGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ChannelQuery(ctx context.Context, in *ChannelQueryTask, opts ...grpc.CallOption) (*ChannelQueryResult, error) {
+	out := new(ChannelQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ChannelQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) ChannelHistory(ctx context.Context, in *ChannelHistoryTask, opts ...grpc.CallOption) (*ChannelHistoryResult, error) {
+	out := new(ChannelHistoryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/ChannelHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutCreate(ctx context.Context, in *RolloutCreateTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutStep(ctx context.Context, in *RolloutStepTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutPause(ctx context.Context, in *RolloutPauseTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutResume(ctx context.Context, in *RolloutResumeTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutResume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutAbort(ctx context.Context, in *RolloutAbortTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutAbort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutGet(ctx context.Context, in *RolloutGetTask, opts ...grpc.CallOption) (*RolloutResult, error) {
+	out := new(RolloutResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutQuery(ctx context.Context, in *RolloutQueryTask, opts ...grpc.CallOption) (*RolloutQueryResult, error) {
+	out := new(RolloutQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RolloutItemQuery(ctx context.Context, in *RolloutItemQueryTask, opts ...grpc.CallOption) (*RolloutItemQueryResult, error) {
+	out := new(RolloutItemQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RolloutItemQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) AuditQuery(ctx context.Context, in *AuditQueryTask, opts ...grpc.CallOption) (*AuditQueryResult, error) {
+	out := new(AuditQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/AuditQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserCreate(ctx context.Context, in *UserCreateTask, opts ...grpc.CallOption) (*UserResult, error) {
+	out := new(UserResult)
+	err := c.cc.Invoke(ctx, "/app.Service/UserCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserRemove(ctx context.Context, in *UserRemoveTask, opts ...grpc.CallOption) (*UserResult, error) {
+	out := new(UserResult)
+	err := c.cc.Invoke(ctx, "/app.Service/UserRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) UserQuery(ctx context.Context, in *UserQueryTask, opts ...grpc.CallOption) (*UserQueryResult, error) {
+	out := new(UserQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/UserQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleSet(ctx context.Context, in *RoleSetTask, opts ...grpc.CallOption) (*RoleResult, error) {
+	out := new(RoleResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleRemove(ctx context.Context, in *RoleRemoveTask, opts ...grpc.CallOption) (*RoleResult, error) {
+	out := new(RoleResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleQuery(ctx context.Context, in *RoleQueryTask, opts ...grpc.CallOption) (*RoleQueryResult, error) {
+	out := new(RoleQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleBindingAdd(ctx context.Context, in *RoleBindingAddTask, opts ...grpc.CallOption) (*RoleBindingResult, error) {
+	out := new(RoleBindingResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleBindingAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleBindingRemove(ctx context.Context, in *RoleBindingRemoveTask, opts ...grpc.CallOption) (*RoleBindingResult, error) {
+	out := new(RoleBindingResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleBindingRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) RoleBindingQuery(ctx context.Context, in *RoleBindingQueryTask, opts ...grpc.CallOption) (*RoleBindingQueryResult, error) {
+	out := new(RoleBindingQueryResult)
+	err := c.cc.Invoke(ctx, "/app.Service/RoleBindingQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations should embed UnimplementedServiceServer
 // for forward compatibility
@@ -331,12 +750,21 @@ type ServiceServer interface {
 	// 查询多个应用版本
 	VerQuery(context.Context, *VerQueryTask) (*VerQueryResult, error)
 	//*
+	// 获取满足版本范围的最高版本
+	VerResolve(context.Context, *VerResolveTask) (*VerResult, error)
+	//*
 	// 获取应用包URL
 	VerGetURL(context.Context, *VerGetURLTask) (*VerGetURLResult, error)
 	//*
 	// 获取应用包上传URL
 	VerUpURL(context.Context, *VerUpURLTask) (*VerUpURLResult, error)
 	//*
+	// 确认应用包已上传
+	VerUpConfirm(context.Context, *VerUpConfirmTask) (*VerResult, error)
+	//*
+	// 校验应用包并提取清单至 info
+	VerVerify(context.Context, *VerVerifyTask) (*VerResult, error)
+	//*
 	// 创建容器
 	ContainerCreate(context.Context, *ContainerCreateTask) (*ContainerResult, error)
 	//*
@@ -352,6 +780,9 @@ type ServiceServer interface {
 	// 查询多个应用版本
 	ContainerQuery(context.Context, *ContainerQueryTask) (*ContainerQueryResult, error)
 	//*
+	// 获取容器启动清单
+	ContainerManifest(context.Context, *ContainerManifestTask) (*ContainerManifestResult, error)
+	//*
 	// 容器添加应用
 	AcAdd(context.Context, *AcAddTask) (*AcResult, error)
 	//*
@@ -366,15 +797,97 @@ type ServiceServer interface {
 	//*
 	// 查询多个容器应用
 	AcQuery(context.Context, *AcQueryTask) (*AcQueryResult, error)
-}
-
-// UnimplementedServiceServer should be embedded to have forward compatible implementations.
-type UnimplementedServiceServer struct {
-}
-
-func (UnimplementedServiceServer) AppCreate(context.Context, *AppCreateTask) (*AppResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppCreate not implemented")
-}
+	//*
+	// 回滚容器应用到历史状态
+	AcRollback(context.Context, *AcRollbackTask) (*AcResult, error)
+	//*
+	// 查询容器应用的变更记录
+	AcHistory(context.Context, *AcHistoryTask) (*AcHistoryResult, error)
+	//*
+	// 监听容器及容器应用变更
+	ContainerWatch(*ContainerWatchTask, Service_ContainerWatchServer) error
+	//*
+	// 监听容器应用变更
+	AcWatch(*AcWatchTask, Service_AcWatchServer) error
+	//*
+	// 创建或修改发布通道，跟随通道的容器应用同步切换版本
+	ChannelSet(context.Context, *ChannelSetTask) (*ChannelResult, error)
+	//*
+	// 删除发布通道
+	ChannelRemove(context.Context, *ChannelRemoveTask) (*ChannelResult, error)
+	//*
+	// 获取发布通道
+	ChannelGet(context.Context, *ChannelGetTask) (*ChannelResult, error)
+	//*
+	// 查询应用的全部发布通道
+	ChannelQuery(context.Context, *ChannelQueryTask) (*ChannelQueryResult, error)
+	//*
+	// 查询发布通道的推送记录
+	ChannelHistory(context.Context, *ChannelHistoryTask) (*ChannelHistoryResult, error)
+	//*
+	// 创建灰度发布，按批次将容器应用从旧版本切换到新版本
+	RolloutCreate(context.Context, *RolloutCreateTask) (*RolloutResult, error)
+	//*
+	// 执行灰度发布的当前批次，并重试之前批次中待执行及失败的容器应用
+	// 批次内全部完成或跳过后进入下一批次
+	RolloutStep(context.Context, *RolloutStepTask) (*RolloutResult, error)
+	//*
+	// 暂停灰度发布
+	RolloutPause(context.Context, *RolloutPauseTask) (*RolloutResult, error)
+	//*
+	// 恢复灰度发布
+	RolloutResume(context.Context, *RolloutResumeTask) (*RolloutResult, error)
+	//*
+	// 终止灰度发布，可选回退已切换的容器
+	RolloutAbort(context.Context, *RolloutAbortTask) (*RolloutResult, error)
+	//*
+	// 获取灰度发布
+	RolloutGet(context.Context, *RolloutGetTask) (*RolloutResult, error)
+	//*
+	// 查询应用的灰度发布
+	RolloutQuery(context.Context, *RolloutQueryTask) (*RolloutQueryResult, error)
+	//*
+	// 查询灰度发布中各容器的进度
+	RolloutItemQuery(context.Context, *RolloutItemQueryTask) (*RolloutItemQueryResult, error)
+	//*
+	// 查询审计日志
+	AuditQuery(context.Context, *AuditQueryTask) (*AuditQueryResult, error)
+	//*
+	// 创建用户
+	UserCreate(context.Context, *UserCreateTask) (*UserResult, error)
+	//*
+	// 删除用户
+	UserRemove(context.Context, *UserRemoveTask) (*UserResult, error)
+	//*
+	// 查询多个用户
+	UserQuery(context.Context, *UserQueryTask) (*UserQueryResult, error)
+	//*
+	// 创建或修改角色
+	RoleSet(context.Context, *RoleSetTask) (*RoleResult, error)
+	//*
+	// 删除角色
+	RoleRemove(context.Context, *RoleRemoveTask) (*RoleResult, error)
+	//*
+	// 查询全部角色
+	RoleQuery(context.Context, *RoleQueryTask) (*RoleQueryResult, error)
+	//*
+	// 用户绑定角色
+	RoleBindingAdd(context.Context, *RoleBindingAddTask) (*RoleBindingResult, error)
+	//*
+	// 用户解绑角色
+	RoleBindingRemove(context.Context, *RoleBindingRemoveTask) (*RoleBindingResult, error)
+	//*
+	// 查询角色绑定
+	RoleBindingQuery(context.Context, *RoleBindingQueryTask) (*RoleBindingQueryResult, error)
+}
+
+// UnimplementedServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedServiceServer struct {
+}
+
+func (UnimplementedServiceServer) AppCreate(context.Context, *AppCreateTask) (*AppResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppCreate not implemented")
+}
 func (UnimplementedServiceServer) AppRemove(context.Context, *AppRemoveTask) (*AppResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppRemove not implemented")
 }
@@ -402,446 +915,1103 @@ func (UnimplementedServiceServer) VerGet(context.Context, *VerGetTask) (*VerResu
 func (UnimplementedServiceServer) VerQuery(context.Context, *VerQueryTask) (*VerQueryResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerQuery not implemented")
 }
+func (UnimplementedServiceServer) VerResolve(context.Context, *VerResolveTask) (*VerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerResolve not implemented")
+}
 func (UnimplementedServiceServer) VerGetURL(context.Context, *VerGetURLTask) (*VerGetURLResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerGetURL not implemented")
 }
 func (UnimplementedServiceServer) VerUpURL(context.Context, *VerUpURLTask) (*VerUpURLResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerUpURL not implemented")
 }
+func (UnimplementedServiceServer) VerUpConfirm(context.Context, *VerUpConfirmTask) (*VerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerUpConfirm not implemented")
+}
+func (UnimplementedServiceServer) VerVerify(context.Context, *VerVerifyTask) (*VerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerVerify not implemented")
+}
 func (UnimplementedServiceServer) ContainerCreate(context.Context, *ContainerCreateTask) (*ContainerResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContainerCreate not implemented")
 }
-func (UnimplementedServiceServer) ContainerRemove(context.Context, *ContainerRemoveTask) (*ContainerResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContainerRemove not implemented")
+func (UnimplementedServiceServer) ContainerRemove(context.Context, *ContainerRemoveTask) (*ContainerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerRemove not implemented")
+}
+func (UnimplementedServiceServer) ContainerSet(context.Context, *ContainerSetTask) (*ContainerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerSet not implemented")
+}
+func (UnimplementedServiceServer) ContainerGet(context.Context, *ContainerGetTask) (*ContainerResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerGet not implemented")
+}
+func (UnimplementedServiceServer) ContainerQuery(context.Context, *ContainerQueryTask) (*ContainerQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerQuery not implemented")
+}
+func (UnimplementedServiceServer) ContainerManifest(context.Context, *ContainerManifestTask) (*ContainerManifestResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerManifest not implemented")
+}
+func (UnimplementedServiceServer) AcAdd(context.Context, *AcAddTask) (*AcResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcAdd not implemented")
+}
+func (UnimplementedServiceServer) AcRemove(context.Context, *AcRemoveTask) (*AcResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcRemove not implemented")
+}
+func (UnimplementedServiceServer) AcSet(context.Context, *AcSetTask) (*AcResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcSet not implemented")
+}
+func (UnimplementedServiceServer) AcGet(context.Context, *AcGetTask) (*AcResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcGet not implemented")
+}
+func (UnimplementedServiceServer) AcQuery(context.Context, *AcQueryTask) (*AcQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcQuery not implemented")
+}
+func (UnimplementedServiceServer) AcRollback(context.Context, *AcRollbackTask) (*AcResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcRollback not implemented")
+}
+func (UnimplementedServiceServer) AcHistory(context.Context, *AcHistoryTask) (*AcHistoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcHistory not implemented")
+}
+func (UnimplementedServiceServer) ContainerWatch(*ContainerWatchTask, Service_ContainerWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerWatch not implemented")
+}
+func (UnimplementedServiceServer) AcWatch(*AcWatchTask, Service_AcWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method AcWatch not implemented")
+}
+func (UnimplementedServiceServer) ChannelSet(context.Context, *ChannelSetTask) (*ChannelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelSet not implemented")
+}
+func (UnimplementedServiceServer) ChannelRemove(context.Context, *ChannelRemoveTask) (*ChannelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelRemove not implemented")
+}
+func (UnimplementedServiceServer) ChannelGet(context.Context, *ChannelGetTask) (*ChannelResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelGet not implemented")
+}
+func (UnimplementedServiceServer) ChannelQuery(context.Context, *ChannelQueryTask) (*ChannelQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelQuery not implemented")
+}
+func (UnimplementedServiceServer) ChannelHistory(context.Context, *ChannelHistoryTask) (*ChannelHistoryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelHistory not implemented")
+}
+func (UnimplementedServiceServer) RolloutCreate(context.Context, *RolloutCreateTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutCreate not implemented")
+}
+func (UnimplementedServiceServer) RolloutStep(context.Context, *RolloutStepTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutStep not implemented")
+}
+func (UnimplementedServiceServer) RolloutPause(context.Context, *RolloutPauseTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutPause not implemented")
+}
+func (UnimplementedServiceServer) RolloutResume(context.Context, *RolloutResumeTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutResume not implemented")
+}
+func (UnimplementedServiceServer) RolloutAbort(context.Context, *RolloutAbortTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutAbort not implemented")
+}
+func (UnimplementedServiceServer) RolloutGet(context.Context, *RolloutGetTask) (*RolloutResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutGet not implemented")
+}
+func (UnimplementedServiceServer) RolloutQuery(context.Context, *RolloutQueryTask) (*RolloutQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutQuery not implemented")
+}
+func (UnimplementedServiceServer) RolloutItemQuery(context.Context, *RolloutItemQueryTask) (*RolloutItemQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RolloutItemQuery not implemented")
+}
+func (UnimplementedServiceServer) AuditQuery(context.Context, *AuditQueryTask) (*AuditQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditQuery not implemented")
+}
+func (UnimplementedServiceServer) UserCreate(context.Context, *UserCreateTask) (*UserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserCreate not implemented")
+}
+func (UnimplementedServiceServer) UserRemove(context.Context, *UserRemoveTask) (*UserResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserRemove not implemented")
+}
+func (UnimplementedServiceServer) UserQuery(context.Context, *UserQueryTask) (*UserQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserQuery not implemented")
+}
+func (UnimplementedServiceServer) RoleSet(context.Context, *RoleSetTask) (*RoleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleSet not implemented")
+}
+func (UnimplementedServiceServer) RoleRemove(context.Context, *RoleRemoveTask) (*RoleResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleRemove not implemented")
+}
+func (UnimplementedServiceServer) RoleQuery(context.Context, *RoleQueryTask) (*RoleQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleQuery not implemented")
+}
+func (UnimplementedServiceServer) RoleBindingAdd(context.Context, *RoleBindingAddTask) (*RoleBindingResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleBindingAdd not implemented")
+}
+func (UnimplementedServiceServer) RoleBindingRemove(context.Context, *RoleBindingRemoveTask) (*RoleBindingResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleBindingRemove not implemented")
+}
+func (UnimplementedServiceServer) RoleBindingQuery(context.Context, *RoleBindingQueryTask) (*RoleBindingQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleBindingQuery not implemented")
+}
+
+// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceServer will
+// result in compilation errors.
+type UnsafeServiceServer interface {
+	mustEmbedUnimplementedServiceServer()
+}
+
+func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
+	s.RegisterService(&Service_ServiceDesc, srv)
+}
+
+func _Service_AppCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AppCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AppCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AppCreate(ctx, req.(*AppCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AppRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRemoveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AppRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AppRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AppRemove(ctx, req.(*AppRemoveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AppSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppSetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AppSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AppSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AppSet(ctx, req.(*AppSetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AppGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppGetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AppGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AppGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AppGet(ctx, req.(*AppGetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AppQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppQueryTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AppQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AppQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AppQuery(ctx, req.(*AppQueryTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerCreate(ctx, req.(*VerCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerRemoveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerRemove(ctx, req.(*VerRemoveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerSetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerSet(ctx, req.(*VerSetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerGetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerGet(ctx, req.(*VerGetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerQueryTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerQuery(ctx, req.(*VerQueryTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerResolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerResolveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerResolve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerResolve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerResolve(ctx, req.(*VerResolveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerGetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerGetURLTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerGetURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerGetURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerGetURL(ctx, req.(*VerGetURLTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerUpURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerUpURLTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerUpURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerUpURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerUpURL(ctx, req.(*VerUpURLTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerUpConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerUpConfirmTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerUpConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerUpConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerUpConfirm(ctx, req.(*VerUpConfirmTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_VerVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerVerifyTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).VerVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/VerVerify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).VerVerify(ctx, req.(*VerVerifyTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerCreate(ctx, req.(*ContainerCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerRemoveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerRemove(ctx, req.(*ContainerRemoveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerSetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerSet(ctx, req.(*ContainerSetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerGetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerGet(ctx, req.(*ContainerGetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerQueryTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerQuery(ctx, req.(*ContainerQueryTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_ContainerManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerManifestTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ContainerManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ContainerManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ContainerManifest(ctx, req.(*ContainerManifestTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcAddTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcAdd(ctx, req.(*AcAddTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcRemoveTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcRemove(ctx, req.(*AcRemoveTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcSetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcSet(ctx, req.(*AcSetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcGetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcGet(ctx, req.(*AcGetTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcQueryTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcQuery(ctx, req.(*AcQueryTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_AcRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcRollbackTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcRollback(ctx, req.(*AcRollbackTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedServiceServer) ContainerSet(context.Context, *ContainerSetTask) (*ContainerResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContainerSet not implemented")
+
+func _Service_AcHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcHistoryTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).AcHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/AcHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).AcHistory(ctx, req.(*AcHistoryTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedServiceServer) ContainerGet(context.Context, *ContainerGetTask) (*ContainerResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContainerGet not implemented")
+
+func _Service_ContainerWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerWatchTask)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).ContainerWatch(m, &serviceContainerWatchServer{stream})
 }
-func (UnimplementedServiceServer) ContainerQuery(context.Context, *ContainerQueryTask) (*ContainerQueryResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContainerQuery not implemented")
+
+type Service_ContainerWatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
 }
-func (UnimplementedServiceServer) AcAdd(context.Context, *AcAddTask) (*AcResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcAdd not implemented")
+
+type serviceContainerWatchServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedServiceServer) AcRemove(context.Context, *AcRemoveTask) (*AcResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcRemove not implemented")
+
+func (x *serviceContainerWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedServiceServer) AcSet(context.Context, *AcSetTask) (*AcResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcSet not implemented")
+
+func _Service_AcWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AcWatchTask)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ServiceServer).AcWatch(m, &serviceAcWatchServer{stream})
 }
-func (UnimplementedServiceServer) AcGet(context.Context, *AcGetTask) (*AcResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcGet not implemented")
+
+type Service_AcWatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
 }
-func (UnimplementedServiceServer) AcQuery(context.Context, *AcQueryTask) (*AcQueryResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcQuery not implemented")
+
+type serviceAcWatchServer struct {
+	grpc.ServerStream
 }
 
-// UnsafeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ServiceServer will
-// result in compilation errors.
-type UnsafeServiceServer interface {
-	mustEmbedUnimplementedServiceServer()
+func (x *serviceAcWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterServiceServer(s grpc.ServiceRegistrar, srv ServiceServer) {
-	s.RegisterService(&Service_ServiceDesc, srv)
+func _Service_ChannelSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelSetTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).ChannelSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/app.Service/ChannelSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).ChannelSet(ctx, req.(*ChannelSetTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AppCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppCreateTask)
+func _Service_ChannelRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelRemoveTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AppCreate(ctx, in)
+		return srv.(ServiceServer).ChannelRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AppCreate",
+		FullMethod: "/app.Service/ChannelRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AppCreate(ctx, req.(*AppCreateTask))
+		return srv.(ServiceServer).ChannelRemove(ctx, req.(*ChannelRemoveTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AppRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppRemoveTask)
+func _Service_ChannelGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelGetTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AppRemove(ctx, in)
+		return srv.(ServiceServer).ChannelGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AppRemove",
+		FullMethod: "/app.Service/ChannelGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AppRemove(ctx, req.(*AppRemoveTask))
+		return srv.(ServiceServer).ChannelGet(ctx, req.(*ChannelGetTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AppSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppSetTask)
+func _Service_ChannelQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AppSet(ctx, in)
+		return srv.(ServiceServer).ChannelQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AppSet",
+		FullMethod: "/app.Service/ChannelQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AppSet(ctx, req.(*AppSetTask))
+		return srv.(ServiceServer).ChannelQuery(ctx, req.(*ChannelQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AppGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppGetTask)
+func _Service_ChannelHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelHistoryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AppGet(ctx, in)
+		return srv.(ServiceServer).ChannelHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AppGet",
+		FullMethod: "/app.Service/ChannelHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AppGet(ctx, req.(*AppGetTask))
+		return srv.(ServiceServer).ChannelHistory(ctx, req.(*ChannelHistoryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AppQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppQueryTask)
+func _Service_RolloutCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutCreateTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AppQuery(ctx, in)
+		return srv.(ServiceServer).RolloutCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AppQuery",
+		FullMethod: "/app.Service/RolloutCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AppQuery(ctx, req.(*AppQueryTask))
+		return srv.(ServiceServer).RolloutCreate(ctx, req.(*RolloutCreateTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerCreateTask)
+func _Service_RolloutStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutStepTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerCreate(ctx, in)
+		return srv.(ServiceServer).RolloutStep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerCreate",
+		FullMethod: "/app.Service/RolloutStep",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerCreate(ctx, req.(*VerCreateTask))
+		return srv.(ServiceServer).RolloutStep(ctx, req.(*RolloutStepTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerRemoveTask)
+func _Service_RolloutPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutPauseTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerRemove(ctx, in)
+		return srv.(ServiceServer).RolloutPause(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerRemove",
+		FullMethod: "/app.Service/RolloutPause",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerRemove(ctx, req.(*VerRemoveTask))
+		return srv.(ServiceServer).RolloutPause(ctx, req.(*RolloutPauseTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerSetTask)
+func _Service_RolloutResume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutResumeTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerSet(ctx, in)
+		return srv.(ServiceServer).RolloutResume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerSet",
+		FullMethod: "/app.Service/RolloutResume",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerSet(ctx, req.(*VerSetTask))
+		return srv.(ServiceServer).RolloutResume(ctx, req.(*RolloutResumeTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerGetTask)
+func _Service_RolloutAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutAbortTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerGet(ctx, in)
+		return srv.(ServiceServer).RolloutAbort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerGet",
+		FullMethod: "/app.Service/RolloutAbort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerGet(ctx, req.(*VerGetTask))
+		return srv.(ServiceServer).RolloutAbort(ctx, req.(*RolloutAbortTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerQueryTask)
+func _Service_RolloutGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutGetTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerQuery(ctx, in)
+		return srv.(ServiceServer).RolloutGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerQuery",
+		FullMethod: "/app.Service/RolloutGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerQuery(ctx, req.(*VerQueryTask))
+		return srv.(ServiceServer).RolloutGet(ctx, req.(*RolloutGetTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerGetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerGetURLTask)
+func _Service_RolloutQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerGetURL(ctx, in)
+		return srv.(ServiceServer).RolloutQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerGetURL",
+		FullMethod: "/app.Service/RolloutQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerGetURL(ctx, req.(*VerGetURLTask))
+		return srv.(ServiceServer).RolloutQuery(ctx, req.(*RolloutQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_VerUpURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerUpURLTask)
+func _Service_RolloutItemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RolloutItemQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).VerUpURL(ctx, in)
+		return srv.(ServiceServer).RolloutItemQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/VerUpURL",
+		FullMethod: "/app.Service/RolloutItemQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).VerUpURL(ctx, req.(*VerUpURLTask))
+		return srv.(ServiceServer).RolloutItemQuery(ctx, req.(*RolloutItemQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ContainerCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerCreateTask)
+func _Service_AuditQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ContainerCreate(ctx, in)
+		return srv.(ServiceServer).AuditQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/ContainerCreate",
+		FullMethod: "/app.Service/AuditQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ContainerCreate(ctx, req.(*ContainerCreateTask))
+		return srv.(ServiceServer).AuditQuery(ctx, req.(*AuditQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ContainerRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerRemoveTask)
+func _Service_UserCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserCreateTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ContainerRemove(ctx, in)
+		return srv.(ServiceServer).UserCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/ContainerRemove",
+		FullMethod: "/app.Service/UserCreate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ContainerRemove(ctx, req.(*ContainerRemoveTask))
+		return srv.(ServiceServer).UserCreate(ctx, req.(*UserCreateTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ContainerSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerSetTask)
+func _Service_UserRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRemoveTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ContainerSet(ctx, in)
+		return srv.(ServiceServer).UserRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/ContainerSet",
+		FullMethod: "/app.Service/UserRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ContainerSet(ctx, req.(*ContainerSetTask))
+		return srv.(ServiceServer).UserRemove(ctx, req.(*UserRemoveTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ContainerGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerGetTask)
+func _Service_UserQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ContainerGet(ctx, in)
+		return srv.(ServiceServer).UserQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/ContainerGet",
+		FullMethod: "/app.Service/UserQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ContainerGet(ctx, req.(*ContainerGetTask))
+		return srv.(ServiceServer).UserQuery(ctx, req.(*UserQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_ContainerQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerQueryTask)
+func _Service_RoleSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleSetTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).ContainerQuery(ctx, in)
+		return srv.(ServiceServer).RoleSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/ContainerQuery",
+		FullMethod: "/app.Service/RoleSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).ContainerQuery(ctx, req.(*ContainerQueryTask))
+		return srv.(ServiceServer).RoleSet(ctx, req.(*RoleSetTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AcAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcAddTask)
+func _Service_RoleRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleRemoveTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AcAdd(ctx, in)
+		return srv.(ServiceServer).RoleRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AcAdd",
+		FullMethod: "/app.Service/RoleRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AcAdd(ctx, req.(*AcAddTask))
+		return srv.(ServiceServer).RoleRemove(ctx, req.(*RoleRemoveTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AcRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcRemoveTask)
+func _Service_RoleQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AcRemove(ctx, in)
+		return srv.(ServiceServer).RoleQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AcRemove",
+		FullMethod: "/app.Service/RoleQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AcRemove(ctx, req.(*AcRemoveTask))
+		return srv.(ServiceServer).RoleQuery(ctx, req.(*RoleQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AcSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcSetTask)
+func _Service_RoleBindingAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBindingAddTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AcSet(ctx, in)
+		return srv.(ServiceServer).RoleBindingAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AcSet",
+		FullMethod: "/app.Service/RoleBindingAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AcSet(ctx, req.(*AcSetTask))
+		return srv.(ServiceServer).RoleBindingAdd(ctx, req.(*RoleBindingAddTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AcGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcGetTask)
+func _Service_RoleBindingRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBindingRemoveTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AcGet(ctx, in)
+		return srv.(ServiceServer).RoleBindingRemove(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AcGet",
+		FullMethod: "/app.Service/RoleBindingRemove",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AcGet(ctx, req.(*AcGetTask))
+		return srv.(ServiceServer).RoleBindingRemove(ctx, req.(*RoleBindingRemoveTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Service_AcQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcQueryTask)
+func _Service_RoleBindingQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleBindingQueryTask)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ServiceServer).AcQuery(ctx, in)
+		return srv.(ServiceServer).RoleBindingQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/app.Service/AcQuery",
+		FullMethod: "/app.Service/RoleBindingQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ServiceServer).AcQuery(ctx, req.(*AcQueryTask))
+		return srv.(ServiceServer).RoleBindingQuery(ctx, req.(*RoleBindingQueryTask))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -893,6 +2063,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerQuery",
 			Handler:    _Service_VerQuery_Handler,
 		},
+		{
+			MethodName: "VerResolve",
+			Handler:    _Service_VerResolve_Handler,
+		},
 		{
 			MethodName: "VerGetURL",
 			Handler:    _Service_VerGetURL_Handler,
@@ -901,6 +2075,14 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerUpURL",
 			Handler:    _Service_VerUpURL_Handler,
 		},
+		{
+			MethodName: "VerUpConfirm",
+			Handler:    _Service_VerUpConfirm_Handler,
+		},
+		{
+			MethodName: "VerVerify",
+			Handler:    _Service_VerVerify_Handler,
+		},
 		{
 			MethodName: "ContainerCreate",
 			Handler:    _Service_ContainerCreate_Handler,
@@ -921,6 +2103,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ContainerQuery",
 			Handler:    _Service_ContainerQuery_Handler,
 		},
+		{
+			MethodName: "ContainerManifest",
+			Handler:    _Service_ContainerManifest_Handler,
+		},
 		{
 			MethodName: "AcAdd",
 			Handler:    _Service_AcAdd_Handler,
@@ -941,7 +2127,118 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcQuery",
 			Handler:    _Service_AcQuery_Handler,
 		},
+		{
+			MethodName: "AcRollback",
+			Handler:    _Service_AcRollback_Handler,
+		},
+		{
+			MethodName: "AcHistory",
+			Handler:    _Service_AcHistory_Handler,
+		},
+		{
+			MethodName: "ChannelSet",
+			Handler:    _Service_ChannelSet_Handler,
+		},
+		{
+			MethodName: "ChannelRemove",
+			Handler:    _Service_ChannelRemove_Handler,
+		},
+		{
+			MethodName: "ChannelGet",
+			Handler:    _Service_ChannelGet_Handler,
+		},
+		{
+			MethodName: "ChannelQuery",
+			Handler:    _Service_ChannelQuery_Handler,
+		},
+		{
+			MethodName: "ChannelHistory",
+			Handler:    _Service_ChannelHistory_Handler,
+		},
+		{
+			MethodName: "RolloutCreate",
+			Handler:    _Service_RolloutCreate_Handler,
+		},
+		{
+			MethodName: "RolloutStep",
+			Handler:    _Service_RolloutStep_Handler,
+		},
+		{
+			MethodName: "RolloutPause",
+			Handler:    _Service_RolloutPause_Handler,
+		},
+		{
+			MethodName: "RolloutResume",
+			Handler:    _Service_RolloutResume_Handler,
+		},
+		{
+			MethodName: "RolloutAbort",
+			Handler:    _Service_RolloutAbort_Handler,
+		},
+		{
+			MethodName: "RolloutGet",
+			Handler:    _Service_RolloutGet_Handler,
+		},
+		{
+			MethodName: "RolloutQuery",
+			Handler:    _Service_RolloutQuery_Handler,
+		},
+		{
+			MethodName: "RolloutItemQuery",
+			Handler:    _Service_RolloutItemQuery_Handler,
+		},
+		{
+			MethodName: "AuditQuery",
+			Handler:    _Service_AuditQuery_Handler,
+		},
+		{
+			MethodName: "UserCreate",
+			Handler:    _Service_UserCreate_Handler,
+		},
+		{
+			MethodName: "UserRemove",
+			Handler:    _Service_UserRemove_Handler,
+		},
+		{
+			MethodName: "UserQuery",
+			Handler:    _Service_UserQuery_Handler,
+		},
+		{
+			MethodName: "RoleSet",
+			Handler:    _Service_RoleSet_Handler,
+		},
+		{
+			MethodName: "RoleRemove",
+			Handler:    _Service_RoleRemove_Handler,
+		},
+		{
+			MethodName: "RoleQuery",
+			Handler:    _Service_RoleQuery_Handler,
+		},
+		{
+			MethodName: "RoleBindingAdd",
+			Handler:    _Service_RoleBindingAdd_Handler,
+		},
+		{
+			MethodName: "RoleBindingRemove",
+			Handler:    _Service_RoleBindingRemove_Handler,
+		},
+		{
+			MethodName: "RoleBindingQuery",
+			Handler:    _Service_RoleBindingQuery_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ContainerWatch",
+			Handler:       _Service_ContainerWatch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AcWatch",
+			Handler:       _Service_AcWatch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "uv-pb-app.proto",
 }
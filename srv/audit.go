@@ -0,0 +1,107 @@
+package srv
+
+import (
+	"context"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AUDIT_APP       = "app"
+	AUDIT_VER       = "ver"
+	AUDIT_CONTAINER = "container"
+	AUDIT_AC        = "ac"
+)
+
+type actorKey struct{}
+
+/**
+* 附加鉴权拦截器认证的调用者
+**/
+func withActor(c context.Context, actor string) context.Context {
+	return context.WithValue(c, actorKey{}, actor)
+}
+
+/**
+* 调用者，仅来自鉴权拦截器认证的身份(admin、container:cid、app:appid、user:uid)，未开启 auth 时为空
+**/
+func getActor(c context.Context) string {
+	actor, _ := c.Value(actorKey{}).(string)
+	return actor
+}
+
+var auditSkipKeys = map[string]bool{"secret": true, "prev_secret": true}
+
+/**
+* 审计日志中不记录密钥
+**/
+func auditDoc(v interface{}) interface{} {
+	switch r := v.(type) {
+	case bson.M:
+		if r == nil {
+			return nil
+		}
+		rs := bson.M{}
+		for key, value := range r {
+			if !auditSkipKeys[key] {
+				rs[key] = value
+			}
+		}
+		return rs
+	case bson.D:
+		rs := bson.D{}
+		for _, e := range r {
+			if !auditSkipKeys[e.Key] {
+				rs = append(rs, e)
+			}
+		}
+		return rs
+	}
+	return v
+}
+
+/**
+* 记录变更审计日志，须与变更在同一事务中调用，写入失败时变更一并失败
+**/
+func audit(c context.Context, ctx micro.Context, app *AppService, method string, etype string, eid string, before interface{}, after interface{}) error {
+	return app.Store.Collection("audit").Create(c,
+		bson.D{bson.E{"etype", etype},
+			bson.E{"eid", eid},
+			bson.E{"method", method},
+			bson.E{"actor", getActor(c)},
+			bson.E{"trace", ctx.Trace()},
+			bson.E{"before", auditDoc(before)},
+			bson.E{"after", auditDoc(after)},
+			bson.E{"ctime", int32(time.Now().Unix())}})
+}
+
+func setAudit(a *pb.Audit, rs bson.M) {
+	if id, ok := rs["_id"].(primitive.ObjectID); ok {
+		a.Id = id.Hex()
+	} else {
+		a.Id = dynamic.StringValue(rs["_id"], "")
+	}
+	a.Type = dynamic.StringValue(rs["etype"], "")
+	a.Eid = dynamic.StringValue(rs["eid"], "")
+	a.Method = dynamic.StringValue(rs["method"], "")
+	a.Actor = dynamic.StringValue(rs["actor"], "")
+	a.Trace = dynamic.StringValue(rs["trace"], "")
+	a.Before = encodeObject(rs["before"])
+	a.After = encodeObject(rs["after"])
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+}
+
+func toAuditItems(rs []bson.M) []*pb.Audit {
+	vs := []*pb.Audit{}
+	for _, r := range rs {
+		v := &pb.Audit{}
+		setAudit(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
@@ -0,0 +1,136 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+	G "google.golang.org/grpc"
+)
+
+/**
+* 指定集合写入失败的存储
+**/
+type failCreateStore struct {
+	Store
+	name string
+}
+
+type failCreateCollection struct {
+	Collection
+	name string
+}
+
+func (s *failCreateStore) Collection(name string) Collection {
+	if name == s.name {
+		return &failCreateCollection{Collection: s.Store.Collection(name), name: name}
+	}
+	return s.Store.Collection(name)
+}
+
+func (c *failCreateCollection) Create(ctx context.Context, doc bson.D) error {
+	return fmt.Errorf("%s unavailable", c.name)
+}
+
+func TestAuditTransaction(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	store := app.Store
+
+	app.Store = &failCreateStore{Store: store, name: "audit"}
+
+	rs, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("AppCreate audit failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	n, err := store.Collection("app").Count(c, bson.D{})
+
+	if err != nil || n != 0 {
+		t.Fatalf("app written without audit %d %v", n, err)
+	}
+
+	app.Store = store
+
+	rs, _ = s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AppCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	appid := rs.Data.Id
+
+	app.Store = &failCreateStore{Store: store, name: "audit"}
+
+	rs, _ = s.AppSet(c, &pb.AppSetTask{Appid: appid, Title: "x"})
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("AppSet audit failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.Store = store
+
+	a, err := store.App().Get(c, appid)
+
+	if err != nil || a["title"] != "demo" {
+		t.Fatalf("app updated without audit %v %v", a, err)
+	}
+
+	n, err = store.Collection("audit").Count(c, bson.D{bson.E{"eid", appid}})
+
+	if err != nil || n != 1 {
+		t.Fatalf("audit count %d %v", n, err)
+	}
+}
+
+/**
+* 调用者只来自鉴权拦截器，客户端 metadata 无法伪造
+**/
+func TestAuditActor(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	grpc.GetContext(c).SetValue("actor", "admin")
+
+	rs, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AppCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	items, err := app.Store.Collection("audit").Find(c, bson.D{bson.E{"eid", rs.Data.Id}}, nil, 0)
+
+	if err != nil || len(items) != 1 || items[0]["actor"] != "" {
+		t.Fatalf("actor from metadata %v %v", items, err)
+	}
+
+	app.Auth = true
+	app.AdminToken = "t0"
+
+	grpc.GetContext(c).SetValue("token", "t0")
+
+	interceptor := NewUnaryAuthInterceptor()
+
+	r, err := interceptor(c, &pb.AppCreateTask{Title: "demo"}, &G.UnaryServerInfo{FullMethod: "/app.Service/AppCreate"}, func(c context.Context, req interface{}) (interface{}, error) {
+		return s.AppCreate(c, req.(*pb.AppCreateTask))
+	})
+
+	if err != nil || r.(*pb.AppResult).Errno != ERRNO_OK {
+		t.Fatalf("AppCreate with auth %v %v", r, err)
+	}
+
+	items, err = app.Store.Collection("audit").Find(c, bson.D{bson.E{"eid", r.(*pb.AppResult).Data.Id}}, nil, 0)
+
+	if err != nil || len(items) != 1 || items[0]["actor"] != "admin" {
+		t.Fatalf("actor from auth %v %v", items, err)
+	}
+}
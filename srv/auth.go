@@ -0,0 +1,348 @@
+package srv
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+	G "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	AUTH_SERVICE = "app.Service"
+)
+
+/**
+* 容器可调用的方法，仅限自身 cid
+**/
+var containerMethods = map[string]bool{
+	"AcGet":             true,
+	"AcQuery":           true,
+	"AcWatch":           true,
+	"ContainerManifest": true,
+	"ContainerWatch":    true,
+}
+
+/**
+* 应用可调用的方法，仅限自身 appid
+**/
+var appMethods = map[string]bool{
+	"AppGet":         true,
+	"VerCreate":      true,
+	"VerSet":         true,
+	"VerGet":         true,
+	"VerQuery":       true,
+	"VerResolve":     true,
+	"VerGetURL":      true,
+	"VerUpURL":       true,
+	"VerUpConfirm":   true,
+	"VerVerify":      true,
+	"ChannelSet":     true,
+	"ChannelGet":     true,
+	"ChannelQuery":   true,
+	"ChannelHistory": true,
+}
+
+const (
+	NONCE_MIN_SIZE = 8
+	NONCE_MAX_SIZE = 64
+)
+
+/**
+* 请求签名 hex(HMAC-SHA256(secret, method + "\n" + timestamp + "\n" + nonce + "\n" + 请求体))
+* 请求体为 protobuf 确定性序列化结果，nonce 为每次请求不同的随机串
+**/
+func Sign(secret string, fullMethod string, timestamp string, nonce string, req interface{}) (string, error) {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	mac.Write([]byte(fullMethod))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+
+	if m, ok := req.(proto.Message); ok {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+		if err != nil {
+			return "", err
+		}
+		mac.Write(b)
+	}
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+/**
+* 签名有效期内已使用的 nonce，过期后清理
+**/
+type nonceSet struct {
+	lock   sync.Mutex
+	items  map[string]int64
+	purged int64
+}
+
+/**
+* 记录 nonce 至 expires，已存在时返回 false
+**/
+func (s *nonceSet) add(key string, now int64, expires int64) bool {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.items == nil {
+		s.items = map[string]int64{}
+	}
+
+	if now != s.purged {
+		for k, v := range s.items {
+			if v < now {
+				delete(s.items, k)
+			}
+		}
+		s.purged = now
+	}
+
+	if v, ok := s.items[key]; ok && v >= now {
+		return false
+	}
+
+	s.items[key] = expires
+
+	return true
+}
+
+func splitMethod(fullMethod string) (string, string) {
+	name := fullMethod[1:]
+	for i := 0; i < len(name); i++ {
+		if name[i] == '/' {
+			return name[:i], name[i+1:]
+		}
+	}
+	return "", name
+}
+
+/**
+* 校验调用者，未开启 auth 时放行，返回认证的调用者
+* 管理员使用 token，容器(cid)、应用(appid)、用户(uid)使用 timestamp + nonce + sign 签名
+* 签名有效期内同一调用者的 nonce 不可重复使用
+* 用户按 role_binding 授权
+**/
+func authorize(c context.Context, fullMethod string, req interface{}) (string, error) {
+
+	ctx := grpc.GetContext(c)
+
+	if ctx == nil {
+		return "", status.Error(codes.Internal, "not found context")
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	if !app.Auth {
+		return "", nil
+	}
+
+	token := ctx.GetValue("token")
+
+	if token != "" {
+		if app.AdminToken != "" && hmac.Equal([]byte(token), []byte(app.AdminToken)) {
+			return "admin", nil
+		}
+		return "", status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	service, method := splitMethod(fullMethod)
+
+	var kind string
+	var id string
+	var methods map[string]bool
+
+	if cid := ctx.GetValue("cid"); cid != "" {
+		kind, id, methods = "container", cid, containerMethods
+	} else if appid := ctx.GetValue("appid"); appid != "" {
+		kind, id, methods = "app", appid, appMethods
+	} else if uid := ctx.GetValue("uid"); uid != "" {
+		kind, id = "user", uid
+	} else {
+		return "", status.Error(codes.Unauthenticated, "not found credential")
+	}
+
+	timestamp := ctx.GetValue("timestamp")
+
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "invalid timestamp")
+	}
+
+	expires := app.AuthExpires
+
+	if expires <= 0 {
+		expires = 300
+	}
+
+	now := time.Now().Unix()
+	d := now - ts
+
+	if d > expires || d < -expires {
+		return "", status.Error(codes.Unauthenticated, "timestamp expired")
+	}
+
+	nonce := ctx.GetValue("nonce")
+
+	if len(nonce) < NONCE_MIN_SIZE || len(nonce) > NONCE_MAX_SIZE {
+		return "", status.Error(codes.Unauthenticated, "invalid nonce")
+	}
+
+	rs, err := app.Store.Collection(kind).Get(c, bson.D{bson.E{"_id", id}})
+
+	if err != nil {
+		if err == ErrNotFound {
+			return "", status.Errorf(codes.Unauthenticated, "not found %s", kind)
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	signed := false
+
+	for _, secret := range app.Secrets(rs) {
+
+		sign, err := Sign(secret, fullMethod, timestamp, nonce, req)
+
+		if err != nil {
+			return "", status.Error(codes.Internal, err.Error())
+		}
+
+		if hmac.Equal([]byte(sign), []byte(ctx.GetValue("sign"))) {
+			signed = true
+			break
+		}
+	}
+
+	if !signed {
+		return "", status.Error(codes.Unauthenticated, "invalid sign")
+	}
+
+	if !app.nonces.add(kind+":"+id+":"+nonce, now, ts+expires) {
+		return "", status.Error(codes.Unauthenticated, "nonce replayed")
+	}
+
+	if service != AUTH_SERVICE {
+		return "", status.Errorf(codes.PermissionDenied, "%s %s not allowed %s", kind, id, method)
+	}
+
+	if kind == "user" {
+
+		ok, err := checkRole(c, app, id, method, req)
+
+		if err != nil {
+			return "", status.Error(codes.Internal, err.Error())
+		}
+
+		if !ok {
+			return "", status.Errorf(codes.PermissionDenied, "%s %s not allowed %s", kind, id, method)
+		}
+
+		return kind + ":" + id, nil
+	}
+
+	if !methods[method] {
+		return "", status.Errorf(codes.PermissionDenied, "%s %s not allowed %s", kind, id, method)
+	}
+
+	var v string
+
+	if kind == "container" {
+		if r, ok := req.(interface{ GetCid() string }); ok {
+			v = r.GetCid()
+		}
+	} else {
+		if r, ok := req.(interface{ GetAppid() string }); ok {
+			v = r.GetAppid()
+		}
+	}
+
+	if v != id {
+		return "", status.Errorf(codes.PermissionDenied, "%s %s not allowed %s", kind, id, method)
+	}
+
+	return kind + ":" + id, nil
+}
+
+/**
+* 鉴权拦截器，需在 grpc.NewUnaryServerInterceptor 之后
+**/
+func NewUnaryAuthInterceptor() G.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *G.UnaryServerInfo, handler G.UnaryHandler) (interface{}, error) {
+		actor, err := authorize(c, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		if actor != "" {
+			c = withActor(c, actor)
+		}
+		return handler(c, req)
+	}
+}
+
+type authServerStream struct {
+	G.ServerStream
+	fullMethod string
+	authorized bool
+	ctx        context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+/**
+* 收到第一个请求后校验
+**/
+func (s *authServerStream) RecvMsg(m interface{}) error {
+
+	err := s.ServerStream.RecvMsg(m)
+
+	if err != nil {
+		return err
+	}
+
+	if !s.authorized {
+		actor, err := authorize(s.Context(), s.fullMethod, m)
+		if err != nil {
+			return err
+		}
+		if actor != "" {
+			s.ctx = withActor(s.ServerStream.Context(), actor)
+		}
+		s.authorized = true
+	}
+
+	return nil
+}
+
+/**
+* 流式鉴权拦截器，需在 NewStreamServerInterceptor 之后
+**/
+func NewStreamAuthInterceptor() G.StreamServerInterceptor {
+	return func(srv interface{}, ss G.ServerStream, info *G.StreamServerInfo, handler G.StreamHandler) error {
+		return handler(srv, &authServerStream{ServerStream: ss, fullMethod: info.FullMethod})
+	}
+}
@@ -0,0 +1,78 @@
+package srv
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+/**
+* 签名包含 nonce，有效期内重复使用的 nonce 被拒绝
+**/
+func TestAuthNonce(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	rs, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AppCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.Auth = true
+
+	appid := rs.Data.Id
+	secret := rs.Data.Secret
+	method := "/app.Service/AppGet"
+	req := &pb.AppGetTask{Appid: appid}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	ctx := grpc.GetContext(c)
+
+	call := func(nonce string, signNonce string) codes.Code {
+		sign, err := Sign(secret, method, timestamp, signNonce, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx.SetValue("appid", appid)
+		ctx.SetValue("timestamp", timestamp)
+		ctx.SetValue("nonce", nonce)
+		ctx.SetValue("sign", sign)
+		_, err = authorize(c, method, req)
+		return status.Code(err)
+	}
+
+	cases := []struct {
+		nonce     string
+		signNonce string
+		code      codes.Code
+	}{
+		{"n0000001", "n0000001", codes.OK},
+		{"n0000001", "n0000001", codes.Unauthenticated},
+		{"n0000002", "n0000001", codes.Unauthenticated},
+		{"n0000002", "n0000002", codes.OK},
+		{"", "", codes.Unauthenticated},
+		{"short", "short", codes.Unauthenticated},
+	}
+
+	for i, e := range cases {
+		if code := call(e.nonce, e.signNonce); code != e.code {
+			t.Fatalf("case %d nonce %s: got %v want %v", i, e.nonce, code, e.code)
+		}
+	}
+
+	/* 过期的 nonce 被清理 */
+
+	var set nonceSet
+
+	if !set.add("a", 100, 101) || set.add("a", 101, 102) || !set.add("a", 102, 103) || len(set.items) != 1 {
+		t.Fatalf("nonceSet %v", set.items)
+	}
+}
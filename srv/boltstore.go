@@ -0,0 +1,95 @@
+package srv
+
+import (
+	"bytes"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var boltBucket = []byte("abi-micro-app")
+
+/**
+* boltdb 键值存储，用于无 mongodb 的单节点部署
+**/
+type boltKV struct {
+	db *bolt.DB
+}
+
+func newBoltKV(path string) (*boltKV, error) {
+
+	d, err := bolt.Open(path, 0600, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+
+	if err != nil {
+		d.Close()
+		return nil, err
+	}
+
+	return &boltKV{db: d}, nil
+}
+
+func (s *boltKV) Get(key []byte) ([]byte, error) {
+
+	var rs []byte
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(boltBucket).Get(key)
+		if v != nil {
+			rs = append([]byte{}, v...)
+		}
+		return nil
+	})
+
+	return rs, err
+}
+
+func (s *boltKV) Write(batch []kvWrite) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+
+		b := tx.Bucket(boltBucket)
+
+		for _, w := range batch {
+
+			var err error
+
+			if w.Value == nil {
+				err = b.Delete(w.Key)
+			} else {
+				err = b.Put(w.Key, w.Value)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *boltKV) Each(prefix []byte, fn func(key []byte, value []byte) bool) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+
+		c := tx.Bucket(boltBucket).Cursor()
+
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if !fn(append([]byte{}, k...), append([]byte{}, v...)) {
+				break
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *boltKV) Close() error {
+	return s.db.Close()
+}
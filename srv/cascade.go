@@ -0,0 +1,46 @@
+package srv
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 删除(或归档)集合中满足条件的文档，返回被删除的文档
+**/
+func removeMany(c context.Context, app *AppService, name string, filter bson.D, archive bool) ([]bson.M, error) {
+
+	items, err := app.Store.Collection(name).RemoveMany(c, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if archive {
+
+		atime := int32(time.Now().Unix())
+
+		coll := app.Store.Collection(name + "_archive")
+
+		for _, item := range items {
+
+			doc := bson.D{}
+
+			for key, value := range item {
+				doc = append(doc, bson.E{key, value})
+			}
+
+			doc = append(doc, bson.E{"atime", atime})
+
+			err = coll.Create(c, doc)
+
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return items, nil
+}
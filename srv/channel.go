@@ -0,0 +1,401 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	AUDIT_CHANNEL     = "channel"
+	CHANNEL_SET_RETRY = 3 // 并发修改发布通道时的重试次数
+)
+
+func setChannel(a *pb.Channel, rs bson.M) {
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.Name = dynamic.StringValue(rs["name"], "")
+	a.Ver = dynamic.StringValue(rs["ver"], "")
+	a.Title = dynamic.StringValue(rs["title"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+	a.Mtime = int32(dynamic.IntValue(rs["mtime"], 0))
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
+}
+
+func toChannelItems(rs []bson.M) []*pb.Channel {
+	vs := []*pb.Channel{}
+	for _, r := range rs {
+		v := &pb.Channel{}
+		setChannel(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func setChannelHistory(a *pb.ChannelHistory, rs bson.M) {
+	if id, ok := rs["_id"].(primitive.ObjectID); ok {
+		a.Id = id.Hex()
+	} else {
+		a.Id = dynamic.StringValue(rs["_id"], "")
+	}
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.Name = dynamic.StringValue(rs["name"], "")
+	a.Ver = dynamic.StringValue(rs["ver"], "")
+	a.PrevVer = dynamic.StringValue(rs["prev_ver"], "")
+	a.Actor = dynamic.StringValue(rs["actor"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+}
+
+func toChannelHistoryItems(rs []bson.M) []*pb.ChannelHistory {
+	vs := []*pb.ChannelHistory{}
+	for _, r := range rs {
+		v := &pb.ChannelHistory{}
+		setChannelHistory(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+/**
+* 获取发布通道当前指向的版本
+**/
+func channelVer(c context.Context, app *AppService, appid string, name string) (string, int32, error) {
+
+	rs, err := app.Store.Collection("channel").Get(c, bson.D{bson.E{"appid", appid}, bson.E{"name", name}})
+
+	if err != nil {
+		if err == ErrNotFound {
+			return "", ERRNO_NOT_FOUND, fmt.Errorf("not found channel %s/%s", appid, name)
+		}
+		return "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	return dynamic.StringValue(rs["ver"], ""), ERRNO_OK, nil
+}
+
+/**
+* 将跟随发布通道的容器应用切换到通道的版本
+**/
+func followChannel(c context.Context, ctx micro.Context, app *AppService, method string, appid string, name string, ver string) error {
+
+	items, err := app.Store.Collection("ac").Find(c, bson.D{bson.E{"appid", appid}, bson.E{"channel", name}, bson.E{"ver", bson.D{bson.E{"$ne", ver}}}}, nil, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+
+		var after bson.M
+
+		err = app.Store.Transaction(c, func(c context.Context) error {
+
+			var err error
+
+			after, err = app.Store.Collection("ac").Update(c,
+				bson.D{bson.E{"cid", item["cid"]}, bson.E{"appid", appid}, bson.E{"channel", name}},
+				&Update{Set: bson.D{bson.E{"ver", ver}}})
+
+			if err != nil {
+				return err
+			}
+
+			err = acHistory(c, app, method, item, after)
+
+			if err != nil {
+				return err
+			}
+
+			return audit(c, ctx, app, method, AUDIT_AC, dynamic.StringValue(item["cid"], "")+"/"+appid, item, after)
+		})
+
+		if err != nil {
+			if err == ErrNotFound {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *server) ChannelSet(c context.Context, task *pb.ChannelSetTask) (*pb.ChannelResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Name == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param name"}, nil
+	}
+
+	if task.Ver == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ver"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	errno, err := checkApp(c, app, task.Appid)
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	errno, err = checkVer(c, app, task.Appid, task.Ver)
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	db_channel := app.Store.Collection("channel")
+
+	now := int32(time.Now().Unix())
+
+	set := bson.D{bson.E{"ver", task.Ver}, bson.E{"mtime", now}}
+
+	if task.Title != "" {
+		set = append(set, bson.E{"title", task.Title})
+	}
+
+	filter := bson.D{bson.E{"appid", task.Appid}, bson.E{"name", task.Name}}
+
+	var rs bson.M
+
+	for i := 0; ; i++ {
+
+		err = app.Store.Transaction(c, func(c context.Context) error {
+
+			var err error
+
+			before := findDoc(c, db_channel, filter)
+
+			// prev_ver 取自 before，更新以 before 的 rev 为条件；before 不存在时仅允许新建，并发新建返回重复
+			rev := task.ExpectedRev
+			where := filter
+
+			if before == nil {
+				where = append(filter[:len(filter):len(filter)], bson.E{"rev", bson.D{bson.E{"$exists", false}}})
+			} else if rev == 0 {
+				rev = int32(dynamic.IntValue(before["rev"], 0))
+			}
+
+			rs, err = db_channel.Update(c, where, &Update{Set: set, SetOnInsert: bson.D{bson.E{"ctime", now}}, Rev: rev, Upsert: true})
+
+			if err != nil {
+				return err
+			}
+
+			err = audit(c, ctx, app, "ChannelSet", AUDIT_CHANNEL, task.Appid+"/"+task.Name, before, rs)
+
+			if err != nil {
+				return err
+			}
+
+			prev := ""
+
+			if before != nil {
+				prev = dynamic.StringValue(before["ver"], "")
+			}
+
+			if prev != task.Ver {
+
+				err = app.Store.Collection("channel_history").Create(c, bson.D{bson.E{"appid", task.Appid},
+					bson.E{"name", task.Name},
+					bson.E{"ver", task.Ver},
+					bson.E{"prev_ver", prev},
+					bson.E{"actor", getActor(c)},
+					bson.E{"ctime", now}})
+
+				if err != nil {
+					return err
+				}
+			}
+
+			return followChannel(c, ctx, app, "ChannelSet", task.Appid, task.Name, task.Ver)
+		})
+
+		// 未指定 expectedRev 时，并发修改或并发新建后重新读取并重试
+		if (err == ErrConflict || err == ErrDuplicate) && task.ExpectedRev == 0 && i < CHANNEL_SET_RETRY {
+			continue
+		}
+
+		break
+	}
+
+	if err == ErrDuplicate {
+		err = ErrConflict
+	}
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "channel")
+		return &pb.ChannelResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Channel{}
+
+	setChannel(a, rs)
+
+	return &pb.ChannelResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) ChannelRemove(c context.Context, task *pb.ChannelRemoveTask) (*pb.ChannelResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Name == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param name"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	ok, err := exists(c, app.Store.Collection("ac"), bson.D{bson.E{"appid", task.Appid}, bson.E{"channel", task.Name}})
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if ok {
+		return &pb.ChannelResult{Errno: ERRNO_CONFLICT, Errmsg: "channel in use"}, nil
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = app.Store.Collection("channel").Remove(c, bson.D{bson.E{"appid", task.Appid}, bson.E{"name", task.Name}}, task.ExpectedRev)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "ChannelRemove", AUDIT_CHANNEL, task.Appid+"/"+task.Name, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "channel")
+		return &pb.ChannelResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Channel{}
+
+	setChannel(a, rs)
+
+	return &pb.ChannelResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) ChannelGet(c context.Context, task *pb.ChannelGetTask) (*pb.ChannelResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Name == "" {
+		return &pb.ChannelResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param name"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ChannelResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := app.Store.Collection("channel").Get(c, bson.D{bson.E{"appid", task.Appid}, bson.E{"name", task.Name}})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "channel")
+		return &pb.ChannelResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Channel{}
+
+	setChannel(a, rs)
+
+	return &pb.ChannelResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) ChannelQuery(c context.Context, task *pb.ChannelQueryTask) (*pb.ChannelQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.ChannelQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ChannelQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	items, err := app.Store.Collection("channel").Find(c, bson.D{bson.E{"appid", task.Appid}}, bson.D{bson.E{"name", 1}}, 0)
+
+	if err != nil {
+		return &pb.ChannelQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.ChannelQueryResult{Errno: ERRNO_OK, Items: toChannelItems(items)}, nil
+}
+
+func (s *server) ChannelHistory(c context.Context, task *pb.ChannelHistoryTask) (*pb.ChannelHistoryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.ChannelHistoryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ChannelHistoryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	filter := bson.D{bson.E{"appid", task.Appid}}
+
+	if task.Name != "" {
+		filter = append(filter, bson.E{"name", task.Name})
+	}
+
+	rs, err := app.Store.Collection("channel_history").Query(c, &Query{Where: filter, P: task.P, N: task.N})
+
+	if err != nil {
+		return &pb.ChannelHistoryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.ChannelHistoryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toChannelHistoryItems(rs.Items)}, nil
+}
@@ -0,0 +1,128 @@
+package srv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 首次读取发布通道时读不到并发新建的通道的存储
+**/
+type staleChannelStore struct {
+	Store
+	done bool
+}
+
+type staleChannelCollection struct {
+	Collection
+	s *staleChannelStore
+}
+
+func (s *staleChannelStore) Collection(name string) Collection {
+	if name == "channel" {
+		return &staleChannelCollection{Collection: s.Store.Collection(name), s: s}
+	}
+	return s.Store.Collection(name)
+}
+
+func (c *staleChannelCollection) Get(ctx context.Context, filter bson.D) (bson.M, error) {
+	if !c.s.done {
+		c.s.done = true
+		return nil, ErrNotFound
+	}
+	return c.Collection.Get(ctx, filter)
+}
+
+func TestChannelSet(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.2.0"})
+
+	store := app.Store
+
+	/* 并发新建后重试，prev_ver 为并发写入的版本 */
+
+	err := store.Collection("channel").Create(c, bson.D{bson.E{"appid", appid}, bson.E{"name", "stable"}, bson.E{"ver", "1.0.0"}, bson.E{"rev", int32(1)}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app.Store = &staleChannelStore{Store: store}
+
+	rs, _ := s.ChannelSet(c, &pb.ChannelSetTask{Appid: appid, Name: "stable", Ver: "1.1.0"})
+
+	app.Store = store
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.1.0" || rs.Data.Rev != 2 {
+		t.Fatalf("ChannelSet race %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	h, _ := s.ChannelHistory(c, &pb.ChannelHistoryTask{Appid: appid, Name: "stable"})
+
+	if h.Errno != ERRNO_OK || len(h.Items) != 1 || h.Items[0].PrevVer != "1.0.0" {
+		t.Fatalf("ChannelHistory race %d %v", h.Errno, h.Items)
+	}
+
+	ac, _ := s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Channel: "stable"})
+
+	if ac.Errno != ERRNO_OK || ac.Data.Ver != "1.1.0" {
+		t.Fatalf("AcAdd channel %d %s %v", ac.Errno, ac.Errmsg, ac.Data)
+	}
+
+	/* 推送记录写入失败时通道及跟随的容器应用不变 */
+
+	app.Store = &failCreateStore{Store: store, name: "channel_history"}
+
+	rs, _ = s.ChannelSet(c, &pb.ChannelSetTask{Appid: appid, Name: "stable", Ver: "1.2.0"})
+
+	app.Store = store
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("ChannelSet history failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	ch, err := store.Collection("channel").Get(c, bson.D{bson.E{"appid", appid}, bson.E{"name", "stable"}})
+
+	if err != nil || ch["ver"] != "1.1.0" {
+		t.Fatalf("channel moved without history %v %v", ch, err)
+	}
+
+	doc, err := store.Ac().Get(c, cid, appid)
+
+	if err != nil || doc["ver"] != "1.1.0" {
+		t.Fatalf("ac moved without history %v %v", doc, err)
+	}
+
+	rs, _ = s.ChannelSet(c, &pb.ChannelSetTask{Appid: appid, Name: "stable", Ver: "1.2.0", ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("ChannelSet rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.ChannelSet(c, &pb.ChannelSetTask{Appid: appid, Name: "stable", Ver: "1.2.0"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.2.0" {
+		t.Fatalf("ChannelSet %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	doc, err = store.Ac().Get(c, cid, appid)
+
+	if err != nil || doc["ver"] != "1.2.0" {
+		t.Fatalf("ac not following channel %v %v", doc, err)
+	}
+}
@@ -13,4 +13,7 @@ const (
 	ERRNO_NOT_FOUND       = 404
 	ERRNO_INTERNAL_SERVER = 500
 	ERRNO_INPUT_DATA      = 400
+	ERRNO_INPUT_JSON      = 4001 // 参数 JSON 格式错误
+	ERRNO_CONFLICT        = 409  // 版本号(rev)冲突或资源被占用
+	ERRNO_DUPLICATE       = 4091 // 唯一键重复，如已存在的版本
 )
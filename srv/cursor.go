@@ -0,0 +1,105 @@
+package srv
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type pageCursor struct {
+	Keys   []string `bson:"k"`
+	Values bson.A   `bson:"v"`
+}
+
+/**
+* 生成下一页游标，记录本页最后一条记录的排序字段值，不足一页时返回空
+* sort 须以 _id 结尾且全部为降序
+**/
+func nextCursor(sort bson.D, items []bson.M, n int32) (string, error) {
+
+	if n < 1 || len(items) < int(n) {
+		return "", nil
+	}
+
+	last := items[len(items)-1]
+
+	v := pageCursor{}
+
+	for _, e := range sort {
+		v.Keys = append(v.Keys, e.Key)
+		v.Values = append(v.Values, last[e.Key])
+	}
+
+	b, err := bson.Marshal(&v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+/**
+* 解析游标，返回上一页最后一条记录的排序字段值，游标的排序字段须与本次查询一致
+**/
+func decodeCursor(sort bson.D, cursor string) (bson.A, error) {
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	v := pageCursor{}
+
+	err = bson.Unmarshal(b, &v)
+
+	if err != nil || len(v.Keys) != len(sort) || len(v.Values) != len(sort) {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	for i, e := range sort {
+
+		if v.Keys[i] != e.Key {
+			return nil, fmt.Errorf("cursor sort mismatch")
+		}
+
+		switch v.Values[i].(type) {
+		case primitive.D, primitive.M, primitive.A:
+			return nil, fmt.Errorf("invalid cursor")
+		}
+	}
+
+	return v.Values, nil
+}
+
+/**
+* 解析游标并追加 keyset 条件
+**/
+func cursorFilter(filter bson.D, sort bson.D, cursor string) (bson.D, error) {
+
+	values, err := decodeCursor(sort, cursor)
+
+	if err != nil {
+		return nil, err
+	}
+
+	or := bson.A{}
+
+	for i, e := range sort {
+
+		cond := bson.D{}
+
+		for j := 0; j < i; j++ {
+			cond = append(cond, bson.E{sort[j].Key, values[j]})
+		}
+
+		cond = append(cond, bson.E{e.Key, bson.D{bson.E{"$lt", values[i]}}})
+
+		or = append(or, cond)
+	}
+
+	return append(filter, bson.E{"$or", or}), nil
+}
@@ -0,0 +1,148 @@
+package srv
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCursorCodec(t *testing.T) {
+
+	sort := bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}
+	oid := primitive.NewObjectID()
+
+	cases := []struct {
+		item bson.M
+	}{
+		{bson.M{"ctime": int32(100), "_id": "a1"}},
+		{bson.M{"ctime": int32(0), "_id": ""}},
+		{bson.M{"ctime": int32(100), "_id": oid}},
+		{bson.M{"ctime": int64(1) << 40, "_id": "a/b+c=="}},
+	}
+
+	for i, e := range cases {
+
+		cursor, err := nextCursor(sort, []bson.M{e.item}, 1)
+
+		if err != nil || cursor == "" {
+			t.Fatalf("case %d encode %q %v", i, cursor, err)
+		}
+
+		values, err := decodeCursor(sort, cursor)
+
+		if err != nil || len(values) != 2 || values[0] != e.item["ctime"] || values[1] != e.item["_id"] {
+			t.Fatalf("case %d decode %v %v", i, values, err)
+		}
+	}
+
+	/* 不足一页时无下一页 */
+
+	cursor, err := nextCursor(sort, []bson.M{{"ctime": int32(1), "_id": "a"}}, 2)
+
+	if err != nil || cursor != "" {
+		t.Fatalf("last page cursor %q %v", cursor, err)
+	}
+
+	encode := func(v interface{}) string {
+		b, err := bson.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	invalid := []string{
+		"",
+		"!!!",
+		base64.RawURLEncoding.EncodeToString([]byte("not bson")),
+		encode(bson.M{"k": bson.A{"ctime"}, "v": bson.A{int32(1)}}),
+		encode(bson.M{"k": bson.A{"ctime", "_id"}, "v": bson.A{int32(1)}}),
+		encode(bson.M{"k": bson.A{"_id", "ctime"}, "v": bson.A{"a", int32(1)}}),
+		encode(bson.M{"k": bson.A{"ctime", "_id"}, "v": bson.A{int32(1), bson.D{bson.E{"$gt", ""}}}}),
+		encode(bson.M{"k": bson.A{"ctime", "_id"}, "v": bson.A{bson.A{int32(1)}, "a"}}),
+	}
+
+	for i, s := range invalid {
+		_, err := decodeCursor(sort, s)
+		if err == nil {
+			t.Errorf("invalid %d %q must fail", i, s)
+		}
+	}
+
+	cursor, _ = nextCursor(sort, []bson.M{{"ctime": int32(1), "_id": "a"}}, 1)
+
+	_, err = decodeCursor(bson.D{bson.E{"mtime", -1}, bson.E{"_id", -1}}, cursor)
+
+	if err == nil {
+		t.Fatalf("cursor with other sort must fail")
+	}
+}
+
+/**
+* 同一 ctime 的记录按 _id 继续翻页
+**/
+func TestCursorFilter(t *testing.T) {
+
+	sort := bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}
+
+	docs := []bson.M{
+		{"ctime": int32(3), "_id": "a1"},
+		{"ctime": int32(2), "_id": "a9"},
+		{"ctime": int32(2), "_id": "a5"},
+		{"ctime": int32(2), "_id": "a2"},
+		{"ctime": int32(1), "_id": "a8"},
+	}
+
+	cases := []struct {
+		last bson.M
+		want []string
+	}{
+		{docs[0], []string{"a9", "a5", "a2", "a8"}},
+		{docs[1], []string{"a5", "a2", "a8"}},
+		{docs[2], []string{"a2", "a8"}},
+		{docs[3], []string{"a8"}},
+		{docs[4], []string{}},
+	}
+
+	for i, e := range cases {
+
+		cursor, err := nextCursor(sort, []bson.M{e.last}, 1)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filter, err := cursorFilter(bson.D{}, sort, cursor)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids := []string{}
+
+		for _, doc := range docs {
+
+			r, err := matchDoc(doc, filter)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if r {
+				ids = append(ids, doc["_id"].(string))
+			}
+		}
+
+		if len(ids) != len(e.want) {
+			t.Fatalf("case %d: got %v want %v", i, ids, e.want)
+		}
+
+		for j := range ids {
+			if ids[j] != e.want[j] {
+				t.Fatalf("case %d: got %v want %v", i, ids, e.want)
+			}
+		}
+	}
+}
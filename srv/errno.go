@@ -0,0 +1,123 @@
+package srv
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ability-sh/abi-micro/grpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	G "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ERRNO_DOMAIN = "abi-micro-app"
+	STATUS_ERROR = "status-error"
+)
+
+/**
+* 错误码对应的 grpc 状态码
+**/
+var errnoCodes = map[int32]codes.Code{
+	ERRNO_INPUT_DATA:      codes.InvalidArgument,
+	ERRNO_INPUT_JSON:      codes.InvalidArgument,
+	ERRNO_NOT_FOUND:       codes.NotFound,
+	ERRNO_CONFLICT:        codes.Aborted,
+	ERRNO_DUPLICATE:       codes.AlreadyExists,
+	ERRNO_INTERNAL_SERVER: codes.Internal,
+}
+
+/**
+* 错误码名称，作为 ErrorInfo.Reason
+**/
+var errnoReasons = map[int32]string{
+	ERRNO_INPUT_DATA:      "INPUT_DATA",
+	ERRNO_INPUT_JSON:      "INPUT_JSON",
+	ERRNO_NOT_FOUND:       "NOT_FOUND",
+	ERRNO_CONFLICT:        "CONFLICT",
+	ERRNO_DUPLICATE:       "DUPLICATE",
+	ERRNO_INTERNAL_SERVER: "INTERNAL_SERVER",
+}
+
+/**
+* 错误码转换为 grpc 状态，附带 ErrorInfo 详情，metadata 中含 errno 及 errmsg
+**/
+func errnoStatus(errno int32, errmsg string) *status.Status {
+
+	code, ok := errnoCodes[errno]
+
+	if !ok {
+		code = codes.Unknown
+	}
+
+	reason, ok := errnoReasons[errno]
+
+	if !ok {
+		reason = strconv.Itoa(int(errno))
+	}
+
+	s := status.New(code, errmsg)
+
+	d, err := s.WithDetails(&errdetails.ErrorInfo{Reason: reason,
+		Domain:   ERRNO_DOMAIN,
+		Metadata: map[string]string{"errno": strconv.Itoa(int(errno)), "errmsg": errmsg}})
+
+	if err != nil {
+		return s
+	}
+
+	return d
+}
+
+/**
+* 是否返回 grpc 状态错误，服务配置 status-error 或请求 metadata status-error 为 true/1 时开启
+**/
+func statusErrorEnabled(c context.Context) bool {
+
+	ctx := grpc.GetContext(c)
+
+	if ctx == nil {
+		return false
+	}
+
+	switch ctx.GetValue(STATUS_ERROR) {
+	case "true", "1":
+		return true
+	case "false", "0":
+		return false
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return false
+	}
+
+	return app.StatusError
+}
+
+/**
+* 错误码拦截器，开启后 errno 不为 ERRNO_OK 的结果同时返回 grpc 状态错误，需在 grpc.NewUnaryServerInterceptor 之后
+**/
+func NewUnaryStatusInterceptor() G.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *G.UnaryServerInfo, handler G.UnaryHandler) (interface{}, error) {
+
+		enabled := statusErrorEnabled(c)
+
+		rs, err := handler(c, req)
+
+		if err != nil || !enabled {
+			return rs, err
+		}
+
+		if r, ok := rs.(interface {
+			GetErrno() int32
+			GetErrmsg() string
+		}); ok && r.GetErrno() != ERRNO_OK {
+			return rs, errnoStatus(r.GetErrno(), r.GetErrmsg()).Err()
+		}
+
+		return rs, nil
+	}
+}
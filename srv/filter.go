@@ -0,0 +1,160 @@
+package srv
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var infoKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_\-]+(\.[A-Za-z0-9_\-]+)*$`)
+
+var infoOps = map[string]string{
+	"eq":     "$eq",
+	"ne":     "$ne",
+	"gt":     "$gt",
+	"gte":    "$gte",
+	"lt":     "$lt",
+	"lte":    "$lte",
+	"in":     "$in",
+	"nin":    "$nin",
+	"exists": "$exists",
+}
+
+/**
+* 解析条件值，非 JSON 时按字符串处理，不允许对象以避免注入查询操作符
+**/
+func infoValue(op string, s string) (interface{}, error) {
+
+	var v interface{} = nil
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		v = s
+	}
+
+	switch op {
+	case "exists":
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("info exists value must be bool")
+		}
+		return b, nil
+	case "in", "nin":
+		vs, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("info %s value must be array", op)
+		}
+		for _, item := range vs {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				return nil, fmt.Errorf("info %s value must be array of scalar", op)
+			}
+		}
+		return vs, nil
+	}
+
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return nil, fmt.Errorf("info %s value must be scalar", op)
+	}
+
+	return v, nil
+}
+
+/**
+* 将过滤条件转换为 mongo 查询条件，status 为 false 时不支持按状态过滤
+* 条件放在 $and 中，避免与调用方已有条件的字段重复
+**/
+func applyFilter(filter bson.D, f *pb.Filter, status bool) (bson.D, error) {
+
+	if f == nil {
+		return filter, nil
+	}
+
+	and := bson.A{}
+
+	if len(f.Statuses) > 0 {
+
+		if !status {
+			return nil, fmt.Errorf("filter statuses not supported")
+		}
+
+		vs := bson.A{}
+
+		for _, s := range f.Statuses {
+			vs = append(vs, s)
+		}
+
+		and = append(and, bson.D{bson.E{"status", bson.D{bson.E{"$in", vs}}}})
+	}
+
+	if f.CtimeStart > 0 || f.CtimeEnd > 0 {
+
+		ctime := bson.D{}
+
+		if f.CtimeStart > 0 {
+			ctime = append(ctime, bson.E{"$gte", f.CtimeStart})
+		}
+
+		if f.CtimeEnd > 0 {
+			ctime = append(ctime, bson.E{"$lt", f.CtimeEnd})
+		}
+
+		and = append(and, bson.D{bson.E{"ctime", ctime}})
+	}
+
+	if f.Title != "" {
+		and = append(and, bson.D{bson.E{"title", f.Title}})
+	}
+
+	if f.Q != "" {
+
+		e, err := searchCond(f.Q, f.Match, f.IgnoreCase)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if e.Key == "$text" {
+			filter = append(filter, e)
+		} else {
+			and = append(and, bson.D{e})
+		}
+	}
+
+	for _, p := range f.Info {
+
+		if !infoKeyPattern.MatchString(p.Key) {
+			return nil, fmt.Errorf("invalid info key %s", p.Key)
+		}
+
+		op := strings.ToLower(p.Op)
+
+		if op == "" {
+			op = "eq"
+		}
+
+		mop, ok := infoOps[op]
+
+		if !ok {
+			return nil, fmt.Errorf("invalid info op %s", p.Op)
+		}
+
+		v, err := infoValue(op, p.Value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		and = append(and, bson.D{bson.E{"info." + p.Key, bson.D{bson.E{mop, v}}}})
+	}
+
+	if len(and) > 0 {
+		filter = append(filter, bson.E{"$and", and})
+	}
+
+	return filter, nil
+}
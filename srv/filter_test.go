@@ -0,0 +1,69 @@
+package srv
+
+import (
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 过滤条件不允许注入查询操作符
+**/
+func TestApplyFilter(t *testing.T) {
+
+	doc := bson.M{
+		"title":  "demo app",
+		"status": int32(1),
+		"ctime":  int32(100),
+		"info":   bson.M{"owner": "bob", "n": float64(3), "tags": bson.A{"x"}},
+	}
+
+	cases := []struct {
+		f       *pb.Filter
+		status  bool
+		ok      bool
+		matched bool
+	}{
+		{nil, false, true, true},
+		{&pb.Filter{Statuses: []int32{1, 2}}, true, true, true},
+		{&pb.Filter{Statuses: []int32{2}}, true, true, false},
+		{&pb.Filter{Statuses: []int32{1}}, false, false, false},
+		{&pb.Filter{CtimeStart: 100, CtimeEnd: 101}, false, true, true},
+		{&pb.Filter{CtimeEnd: 100}, false, true, false},
+		{&pb.Filter{Q: "app", Match: pb.FilterMatch_MATCH_CONTAINS}, false, true, true},
+		{&pb.Filter{Q: "d.mo", Match: pb.FilterMatch_MATCH_CONTAINS}, false, true, false},
+		{&pb.Filter{Q: "(a+)+", Match: pb.FilterMatch_MATCH_REGEX}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Value: "bob"}}}, false, true, true},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "NE", Value: "bob"}}}, false, true, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "n", Op: "gte", Value: "3"}}}, false, true, true},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "in", Value: `["bob","amy"]`}}}, false, true, true},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "missing", Op: "exists", Value: "false"}}}, false, true, true},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Value: `{"$ne":"x"}`}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "in", Value: `[{"$ne":"x"}]`}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "in", Value: "bob"}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "exists", Value: "1"}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "owner", Op: "where", Value: "1"}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "$where", Value: "1"}}}, false, false, false},
+		{&pb.Filter{Info: []*pb.InfoPredicate{{Key: "a..b", Value: "1"}}}, false, false, false},
+	}
+
+	for i, e := range cases {
+
+		filter, err := applyFilter(bson.D{}, e.f, e.status)
+
+		if (err == nil) != e.ok {
+			t.Fatalf("case %d: got %v want ok %v", i, err, e.ok)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		r, err := matchDoc(doc, filter)
+
+		if err != nil || r != e.matched {
+			t.Fatalf("case %d %v: got %v %v want %v", i, filter, r, err, e.matched)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package srv
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro/micro"
+	"github.com/ability-sh/abi-micro/oss"
+)
+
+/**
+* 本地文件系统 OSS，用于无对象存储的单节点部署及测试，不支持签名 URL
+**/
+type fsOSS struct {
+	dir     string
+	baseURL string
+}
+
+func newFSOSS(dir string, baseURL string) *fsOSS {
+	return &fsOSS{dir: dir, baseURL: baseURL}
+}
+
+/**
+* key 对应的文件路径，不允许跳出 dir
+**/
+func (s *fsOSS) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+func (s *fsOSS) Get(key string) ([]byte, error) {
+	return os.ReadFile(s.path(key))
+}
+
+func (s *fsOSS) GetURL(key string) string {
+	if s.baseURL == "" {
+		return ""
+	}
+	return s.baseURL + path.Clean("/"+key)
+}
+
+func (s *fsOSS) GetSignURL(key string, expires time.Duration) (string, error) {
+	return "", fmt.Errorf("GetSignURL not supported")
+}
+
+func (s *fsOSS) Put(key string, data []byte, header map[string]string) error {
+
+	p := s.path(key)
+
+	err := os.MkdirAll(filepath.Dir(p), 0755)
+
+	if err != nil {
+		return err
+	}
+
+	tmp := p + ".tmp"
+
+	err = os.WriteFile(tmp, data, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p)
+}
+
+func (s *fsOSS) PutSignURL(key string, expires time.Duration) (string, error) {
+	return "", fmt.Errorf("PutSignURL not supported")
+}
+
+func (s *fsOSS) PostSignURL(key string, expires time.Duration, maxSize int64, header map[string]string) (string, map[string]string, error) {
+	return "", nil, fmt.Errorf("PostSignURL not supported")
+}
+
+func (s *fsOSS) Del(key string) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *fsOSS) Has(key string) (bool, error) {
+	_, err := os.Stat(s.path(key))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+/**
+* 打开对象，读取应用包时按大小上限读取
+**/
+func (s *fsOSS) Open(key string) (io.ReadCloser, error) {
+	return os.Open(s.path(key))
+}
+
+func (s *fsOSS) Recycle() {}
+
+/**
+* 本地文件系统 OSS 服务，配置 dir 及 baseURL
+**/
+type fsOSSService struct {
+	config interface{}
+	name   string
+	oss    *fsOSS
+}
+
+func newFSOSSService(name string, config interface{}) *fsOSSService {
+	return &fsOSSService{name: name, config: config}
+}
+
+func (s *fsOSSService) Name() string {
+	return s.name
+}
+
+func (s *fsOSSService) Config() interface{} {
+	return s.config
+}
+
+func (s *fsOSSService) OnInit(ctx micro.Context) error {
+
+	dir := dynamic.StringValue(dynamic.Get(s.config, "dir"), "")
+
+	if dir == "" {
+		return fmt.Errorf("not found dir")
+	}
+
+	err := os.MkdirAll(dir, 0755)
+
+	if err != nil {
+		return err
+	}
+
+	s.oss = newFSOSS(dir, dynamic.StringValue(dynamic.Get(s.config, "baseURL"), ""))
+
+	return nil
+}
+
+func (s *fsOSSService) OnValid(ctx micro.Context) error {
+	return nil
+}
+
+func (s *fsOSSService) OSS() oss.OSS {
+	return s.oss
+}
+
+func (s *fsOSSService) Recycle() {}
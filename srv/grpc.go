@@ -0,0 +1,83 @@
+package srv
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ability-sh/abi-micro/micro"
+	G "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var traceKeys = []string{"trace", "Trace"}
+
+type serverStream struct {
+	G.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+/**
+* 流式调用拦截器，与 grpc.NewUnaryServerInterceptor 一致创建调用上下文
+**/
+func NewStreamServerInterceptor(p micro.Payload) G.StreamServerInterceptor {
+
+	return func(srv interface{}, ss G.ServerStream, info *G.StreamServerInfo, handler G.StreamHandler) error {
+
+		md, ok := metadata.FromIncomingContext(ss.Context())
+
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "Retrieving metadata is failed")
+		}
+
+		var trace string = ""
+
+		for _, key := range traceKeys {
+
+			vs := md[key]
+
+			if len(vs) > 0 {
+				trace = vs[0]
+				break
+			}
+		}
+
+		if trace == "" {
+			trace = micro.NewTrace()
+		}
+
+		name := info.FullMethod[1:]
+		i := strings.Index(name, "/")
+
+		if i >= 0 {
+			name = name[i+1:]
+		}
+
+		c, err := p.NewContext(name, trace)
+
+		if err != nil {
+			return err
+		}
+
+		defer c.Recycle()
+
+		for key, vs := range md {
+			if len(vs) > 0 {
+				c.SetValue(key, vs[0])
+			}
+		}
+
+		err = handler(srv, &serverStream{ServerStream: ss, ctx: micro.WithContext(ss.Context(), c)})
+
+		if err != nil {
+			c.Printf("[err:1] %s", err.Error())
+		}
+
+		return err
+	}
+}
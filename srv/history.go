@@ -0,0 +1,292 @@
+package srv
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func setAcHistory(a *pb.AcHistory, rs bson.M) {
+	a.Cid = dynamic.StringValue(rs["cid"], "")
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
+	a.Ver = dynamic.StringValue(rs["ver"], "")
+	a.VerRange = dynamic.StringValue(rs["range"], "")
+	a.Channel = dynamic.StringValue(rs["channel"], "")
+	a.Env = acEnv(rs["env"])
+	a.Method = dynamic.StringValue(rs["method"], "")
+	a.Actor = dynamic.StringValue(rs["actor"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+}
+
+func toAcHistoryItems(rs []bson.M) []*pb.AcHistory {
+	vs := []*pb.AcHistory{}
+	for _, r := range rs {
+		v := &pb.AcHistory{}
+		setAcHistory(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func acEnv(v interface{}) map[string]string {
+	env := map[string]string{}
+	dynamic.Each(v, func(key interface{}, value interface{}) bool {
+		env[dynamic.StringValue(key, "")] = dynamic.StringValue(value, "")
+		return true
+	})
+	return env
+}
+
+/**
+* 版本或环境变量是否变化
+**/
+func acChanged(before bson.M, after bson.M) bool {
+
+	for _, key := range []string{"ver", "range", "channel"} {
+		if dynamic.StringValue(before[key], "") != dynamic.StringValue(after[key], "") {
+			return true
+		}
+	}
+
+	b, _ := json.Marshal(acEnv(before["env"]))
+	a, _ := json.Marshal(acEnv(after["env"]))
+
+	return string(b) != string(a)
+}
+
+func acHistoryDoc(c context.Context, method string, rs bson.M) bson.D {
+	return bson.D{bson.E{"cid", dynamic.StringValue(rs["cid"], "")},
+		bson.E{"appid", dynamic.StringValue(rs["appid"], "")},
+		bson.E{"rev", int32(dynamic.IntValue(rs["rev"], 0))},
+		bson.E{"ver", dynamic.StringValue(rs["ver"], "")},
+		bson.E{"range", dynamic.StringValue(rs["range"], "")},
+		bson.E{"channel", dynamic.StringValue(rs["channel"], "")},
+		bson.E{"env", acEnv(rs["env"])},
+		bson.E{"method", method},
+		bson.E{"actor", getActor(c)},
+		bson.E{"ctime", int32(time.Now().Unix())}}
+}
+
+/**
+* 容器应用版本或环境变量变化时记录变更后的状态，首次记录时同时保存变更前的状态
+* 须与容器应用的变更在同一事务中调用，写入失败时变更一并失败
+**/
+func acHistory(c context.Context, app *AppService, method string, before bson.M, after bson.M) error {
+
+	if after == nil || (before != nil && !acChanged(before, after)) {
+		return nil
+	}
+
+	db_ac_history := app.Store.Collection("ac_history")
+
+	if before != nil {
+
+		ok, err := exists(c, db_ac_history, bson.D{bson.E{"cid", before["cid"]}, bson.E{"appid", before["appid"]}})
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+
+			err = db_ac_history.Create(c, acHistoryDoc(c, "", before))
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return db_ac_history.Create(c, acHistoryDoc(c, method, after))
+}
+
+/**
+* 容器应用的起始 rev，删除后重新添加时接续保留的变更记录，保证按 rev 回退的顺序
+**/
+func nextAcRev(c context.Context, app *AppService, cid string, appid string) (int32, error) {
+
+	items, err := app.Store.Collection("ac_history").Find(c, bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}}, bson.D{bson.E{"rev", -1}, bson.E{"_id", -1}}, 1)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(items) == 0 {
+		return 1, nil
+	}
+
+	return int32(dynamic.IntValue(items[0]["rev"], 0)) + 1, nil
+}
+
+/**
+* 删除容器应用时保留变更记录，并记录删除前的状态，重新添加后仍可审计及回退
+**/
+func acRemoveHistory(c context.Context, app *AppService, method string, before bson.M) error {
+
+	doc := acHistoryDoc(c, method, before)
+
+	for i, e := range doc {
+		if e.Key == "rev" {
+			doc[i].Value = int32(dynamic.IntValue(before["rev"], 0)) + 1
+		}
+	}
+
+	return app.Store.Collection("ac_history").Create(c, doc)
+}
+
+func (s *server) AcRollback(c context.Context, task *pb.AcRollbackTask) (*pb.AcResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Cid == "" {
+		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"}, nil
+	}
+
+	if task.Appid == "" {
+		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Steps <= 0 && task.ToRev <= 0 {
+		task.Steps = 1
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	db_ac_history := app.Store.Collection("ac_history")
+
+	filter := bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}}
+
+	before := findDoc(c, app.Store.Collection("ac"), filter)
+
+	if before == nil {
+		return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ac"}, nil
+	}
+
+	rev := int32(dynamic.IntValue(before["rev"], 0))
+
+	if task.ExpectedRev > 0 && task.ExpectedRev != rev {
+		return &pb.AcResult{Errno: ERRNO_CONFLICT, Errmsg: "ac rev conflict"}, nil
+	}
+
+	// 最近一条记录即当前状态，steps 为向前回退的变更次数
+	var items []bson.M
+
+	if task.ToRev > 0 {
+
+		items, err = db_ac_history.Find(c, append(filter, bson.E{"rev", bson.D{bson.E{"$lte", task.ToRev}}}),
+			bson.D{bson.E{"rev", -1}, bson.E{"_id", -1}}, 1)
+
+	} else {
+
+		items, err = db_ac_history.Find(c, append(filter, bson.E{"rev", bson.D{bson.E{"$lte", rev}}}),
+			bson.D{bson.E{"rev", -1}, bson.E{"_id", -1}}, int64(task.Steps)+1)
+
+		if len(items) > 0 && len(items) <= int(task.Steps) {
+			items = nil
+		}
+	}
+
+	if err != nil {
+		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if len(items) == 0 {
+		return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ac history"}, nil
+	}
+
+	target := items[len(items)-1]
+
+	ver := dynamic.StringValue(target["ver"], "")
+
+	if ver != "" {
+
+		errno, err := checkVer(c, app, task.Appid, ver)
+
+		if err != nil {
+			return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
+		}
+	}
+
+	set := bson.D{bson.E{"ver", ver},
+		bson.E{"range", dynamic.StringValue(target["range"], "")},
+		bson.E{"channel", dynamic.StringValue(target["channel"], "")},
+		bson.E{"env", acEnv(target["env"])}}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = app.Store.Ac().Update(c, task.Cid, task.Appid, &Update{Set: set, Rev: rev})
+
+		if err != nil {
+			return err
+		}
+
+		err = acHistory(c, app, "AcRollback", before, rs)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "AcRollback", AUDIT_AC, task.Cid+"/"+task.Appid, before, rs)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ac")
+		return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Ac{}
+
+	setAc(a, rs)
+
+	return &pb.AcResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) AcHistory(c context.Context, task *pb.AcHistoryTask) (*pb.AcHistoryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Cid == "" {
+		return &pb.AcHistoryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"}, nil
+	}
+
+	if task.Appid == "" {
+		return &pb.AcHistoryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.AcHistoryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	q := &Query{Where: bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}},
+		Sort: bson.D{bson.E{"rev", -1}, bson.E{"_id", -1}},
+		P:    task.P,
+		N:    task.N}
+
+	rs, err := app.Store.Collection("ac_history").Query(c, q)
+
+	if err != nil {
+		return &pb.AcHistoryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.AcHistoryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toAcHistoryItems(rs.Items)}, nil
+}
@@ -0,0 +1,73 @@
+package srv
+
+import (
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 变更记录写入失败时容器应用的变更一并失败
+**/
+func TestAcHistoryTransaction(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+
+	store := app.Store
+
+	app.Store = &failCreateStore{Store: store, name: "ac_history"}
+
+	rs, _ := s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("AcAdd history failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	_, err := store.Ac().Get(c, cid, appid)
+
+	if err != ErrNotFound {
+		t.Fatalf("ac added without history %v", err)
+	}
+
+	app.Store = store
+
+	rs, _ = s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AcAdd %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.Store = &failCreateStore{Store: store, name: "ac_history"}
+
+	rs, _ = s.AcSet(c, &pb.AcSetTask{Cid: cid, Appid: appid, Ver: "1.1.0"})
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("AcSet history failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.Store = store
+
+	ac, err := store.Ac().Get(c, cid, appid)
+
+	if err != nil || ac["ver"] != "1.0.0" {
+		t.Fatalf("ac updated without history %v %v", ac, err)
+	}
+
+	n, err := store.Collection("ac_history").Count(c, bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}})
+
+	if err != nil || n != 1 {
+		t.Fatalf("ac_history count %d %v", n, err)
+	}
+}
@@ -0,0 +1,322 @@
+package srv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	G "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IDEMPOTENCY_KEY_MAX_LENGTH = 128
+)
+
+/**
+* 幂等键有效秒数，默认 86400
+**/
+func (s *AppService) idempotencyExpires() int64 {
+	if s.IdempotencyExpires <= 0 {
+		return 86400
+	}
+	return s.IdempotencyExpires
+}
+
+/**
+* 请求摘要，同一幂等键的请求内容须一致
+**/
+func requestHash(req interface{}) (string, error) {
+
+	m, ok := req.(proto.Message)
+
+	if !ok {
+		return "", fmt.Errorf("request not proto message")
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+/**
+* 结果序列化后按密钥方式加密存储，AppCreate 等结果中含有密钥
+**/
+func encodeIdempotencyResult(app *AppService, rs interface{}) (string, error) {
+
+	m, ok := rs.(proto.Message)
+
+	if !ok {
+		return "", fmt.Errorf("result not proto message")
+	}
+
+	a, err := anypb.New(m)
+
+	if err != nil {
+		return "", err
+	}
+
+	b, err := proto.Marshal(a)
+
+	if err != nil {
+		return "", err
+	}
+
+	return app.EncryptSecret(base64.RawStdEncoding.EncodeToString(b))
+}
+
+func decodeIdempotencyResult(app *AppService, v string) (interface{}, error) {
+
+	v, err := app.DecryptSecret(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := base64.RawStdEncoding.DecodeString(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	a := &anypb.Any{}
+
+	err = proto.Unmarshal(b, a)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return a.UnmarshalNew()
+}
+
+/**
+* 处理中的幂等键租约秒数，默认 60，超过后视为处理方已失效，重复请求可接管
+**/
+func (s *AppService) idempotencyLease() int64 {
+	if s.IdempotencyLease <= 0 {
+		return 60
+	}
+	return s.IdempotencyLease
+}
+
+/**
+* 按方法的返回类型生成错误结果，由 NewUnaryStatusInterceptor 按 status-error 决定是否转换为 grpc 状态错误
+* 无法生成返回类型时直接返回 grpc 状态错误
+**/
+func errnoResult(info *G.UnaryServerInfo, errno int32, errmsg string) (interface{}, error) {
+
+	i := strings.LastIndex(info.FullMethod, "/")
+
+	if i > 0 {
+
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(info.FullMethod[:i], "/")))
+
+		if err == nil {
+
+			if sd, ok := d.(protoreflect.ServiceDescriptor); ok {
+
+				if md := sd.Methods().ByName(protoreflect.Name(info.FullMethod[i+1:])); md != nil {
+
+					mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+
+					if err == nil {
+
+						m := mt.New()
+						fields := m.Descriptor().Fields()
+						fErrno := fields.ByName("errno")
+						fErrmsg := fields.ByName("errmsg")
+
+						if fErrno != nil && fErrmsg != nil {
+							m.Set(fErrno, protoreflect.ValueOfInt32(errno))
+							m.Set(fErrmsg, protoreflect.ValueOfString(errmsg))
+							return m.Interface(), nil
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return nil, errnoStatus(errno, errmsg).Err()
+}
+
+/**
+* 占用幂等键，返回占用后的 rev；键已有成功结果时返回结果
+* 过期的键视为不存在，租约过期的处理中键按 rev 接管
+**/
+func claimIdempotency(c context.Context, app *AppService, key string, hash string) (int32, interface{}, int32, string) {
+
+	for i := 0; i < 3; i++ {
+
+		now := time.Now()
+		lease := now.Add(time.Duration(app.idempotencyLease()) * time.Second)
+
+		err := app.Store.Idempotency().Create(c, bson.D{bson.E{"_id", key},
+			bson.E{"hash", hash},
+			bson.E{"ctime", int32(now.Unix())},
+			bson.E{"etime", now.Add(time.Duration(app.idempotencyExpires()) * time.Second)},
+			bson.E{"lease", lease},
+			bson.E{"rev", int32(1)}})
+
+		if err == nil {
+			return 1, nil, ERRNO_OK, ""
+		}
+
+		if err != ErrDuplicate {
+			return 0, nil, ERRNO_INTERNAL_SERVER, err.Error()
+		}
+
+		rs, err := app.Store.Idempotency().Get(c, key)
+
+		if err == ErrNotFound {
+			continue
+		}
+
+		if err != nil {
+			return 0, nil, ERRNO_INTERNAL_SERVER, err.Error()
+		}
+
+		if dynamic.StringValue(rs["hash"], "") != hash {
+			return 0, nil, ERRNO_CONFLICT, "idempotency key reused with different request"
+		}
+
+		result := dynamic.StringValue(rs["result"], "")
+
+		if result != "" {
+
+			v, err := decodeIdempotencyResult(app, result)
+
+			if err != nil {
+				return 0, nil, ERRNO_INTERNAL_SERVER, err.Error()
+			}
+
+			return 0, v, ERRNO_OK, ""
+		}
+
+		if t, ok := rs["lease"].(primitive.DateTime); ok && t.Time().After(now) {
+			return 0, nil, ERRNO_CONFLICT, "idempotency key request in progress"
+		}
+
+		rs, err = app.Store.Idempotency().Update(c, key, &Update{Set: bson.D{bson.E{"lease", lease}},
+			Rev: int32(dynamic.IntValue(rs["rev"], 0))})
+
+		if err == ErrNotFound || err == ErrConflict {
+			continue
+		}
+
+		if err != nil {
+			return 0, nil, ERRNO_INTERNAL_SERVER, err.Error()
+		}
+
+		return int32(dynamic.IntValue(rs["rev"], 0)), nil, ERRNO_OK, ""
+	}
+
+	return 0, nil, ERRNO_CONFLICT, "idempotency key request in progress"
+}
+
+/**
+* 幂等拦截器，创建类请求携带 idempotencyKey 时，有效期内重复调用返回首次成功的结果
+* 幂等键按方法及调用者隔离，需在 NewUnaryAuthInterceptor 及 NewUnaryStatusInterceptor 之后
+* 处理失败、返回错误码或 panic 时释放幂等键，处理中的键在租约(idempotency-lease)过期后可被接管
+**/
+func NewUnaryIdempotencyInterceptor() G.UnaryServerInterceptor {
+	return func(c context.Context, req interface{}, info *G.UnaryServerInfo, handler G.UnaryHandler) (rs interface{}, err error) {
+
+		r, ok := req.(interface{ GetIdempotencyKey() string })
+
+		if !ok || r.GetIdempotencyKey() == "" {
+			return handler(c, req)
+		}
+
+		if len(r.GetIdempotencyKey()) > IDEMPOTENCY_KEY_MAX_LENGTH {
+			return errnoResult(info, ERRNO_INPUT_DATA, fmt.Sprintf("param idempotencyKey too long, max %d", IDEMPOTENCY_KEY_MAX_LENGTH))
+		}
+
+		ctx := grpc.GetContext(c)
+
+		if ctx == nil {
+			return nil, status.Error(codes.Internal, "not found context")
+		}
+
+		app, err := GetAppService(ctx, SERVICE_APP)
+
+		if err != nil {
+			return errnoResult(info, ERRNO_INTERNAL_SERVER, err.Error())
+		}
+
+		hash, err := requestHash(req)
+
+		if err != nil {
+			return errnoResult(info, ERRNO_INTERNAL_SERVER, err.Error())
+		}
+
+		key := fmt.Sprintf("%s\n%s\n%s", info.FullMethod, getActor(c), r.GetIdempotencyKey())
+
+		rev, v, errno, errmsg := claimIdempotency(c, app, key, hash)
+
+		if errno != ERRNO_OK {
+			return errnoResult(info, errno, errmsg)
+		}
+
+		if v != nil {
+			return v, nil
+		}
+
+		/* 处理函数会回收 ctx，之后只能使用标准日志 */
+
+		done := false
+
+		defer func() {
+
+			if done {
+				return
+			}
+
+			_, e := app.Store.Idempotency().Remove(c, key, rev)
+
+			if e != nil && e != ErrNotFound && e != ErrConflict {
+				log.Println("idempotency", key, e)
+			}
+		}()
+
+		rs, err = handler(c, req)
+
+		if e, ok := rs.(interface{ GetErrno() int32 }); err == nil && ok && e.GetErrno() == ERRNO_OK {
+
+			result, e := encodeIdempotencyResult(app, rs)
+
+			if e == nil {
+				_, e = app.Store.Idempotency().Update(c, key, &Update{Set: bson.D{bson.E{"result", result}}, Rev: rev})
+			}
+
+			if e == nil {
+				done = true
+			} else {
+				log.Println("idempotency", key, e)
+			}
+		}
+
+		return rs, err
+	}
+}
@@ -0,0 +1,217 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+	G "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type idempotencyTest struct {
+	app     *AppService
+	c       context.Context
+	info    *G.UnaryServerInfo
+	calls   int
+	handler G.UnaryHandler
+}
+
+func newIdempotencyTest() *idempotencyTest {
+
+	app := newTestApp()
+	app.SecretKey = "test-secret-key"
+
+	r := &idempotencyTest{app: app, c: newTestContext(app), info: &G.UnaryServerInfo{FullMethod: "/app.Service/AppCreate"}}
+
+	r.handler = func(c context.Context, req interface{}) (interface{}, error) {
+		r.calls = r.calls + 1
+		return (&server{}).AppCreate(c, req.(*pb.AppCreateTask))
+	}
+
+	return r
+}
+
+func (r *idempotencyTest) call(task *pb.AppCreateTask, handler G.UnaryHandler) (*pb.AppResult, error) {
+
+	if handler == nil {
+		handler = r.handler
+	}
+
+	idempotency := NewUnaryIdempotencyInterceptor()
+
+	rs, err := NewUnaryStatusInterceptor()(r.c, task, r.info, func(c context.Context, req interface{}) (interface{}, error) {
+		return idempotency(c, req, r.info, handler)
+	})
+
+	if rs == nil {
+		return nil, err
+	}
+
+	return rs.(*pb.AppResult), err
+}
+
+func (r *idempotencyTest) key(key string) string {
+	return fmt.Sprintf("%s\n%s\n%s", r.info.FullMethod, "", key)
+}
+
+func TestIdempotencyReplay(t *testing.T) {
+
+	r := newIdempotencyTest()
+
+	task := &pb.AppCreateTask{Title: "demo", IdempotencyKey: "k1"}
+
+	a, err := r.call(task, nil)
+
+	if err != nil || a.Errno != ERRNO_OK {
+		t.Fatalf("first call %v %v", a, err)
+	}
+
+	b, err := r.call(task, nil)
+
+	if err != nil || b.Errno != ERRNO_OK || b.Data.Id != a.Data.Id || r.calls != 1 {
+		t.Fatalf("replay %v %v calls %d", b, err, r.calls)
+	}
+
+	c, err := r.call(&pb.AppCreateTask{Title: "other", IdempotencyKey: "k1"}, nil)
+
+	if err != nil || c.Errno != ERRNO_CONFLICT || r.calls != 1 {
+		t.Fatalf("reused key %v %v", c, err)
+	}
+}
+
+/**
+* 处理失败、返回错误码或 panic 时释放幂等键，重试会再次执行
+**/
+func TestIdempotencyRelease(t *testing.T) {
+
+	r := newIdempotencyTest()
+
+	task := &pb.AppCreateTask{Title: "demo", IdempotencyKey: "k2"}
+
+	_, err := r.call(task, func(c context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("failed")
+	})
+
+	if err == nil {
+		t.Fatalf("handler error not returned")
+	}
+
+	a, _ := r.call(task, func(c context.Context, req interface{}) (interface{}, error) {
+		return &pb.AppResult{Errno: ERRNO_INPUT_DATA, Errmsg: "bad"}, nil
+	})
+
+	if a.Errno != ERRNO_INPUT_DATA {
+		t.Fatalf("errno result %v", a)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("panic not propagated")
+			}
+		}()
+		r.call(task, func(c context.Context, req interface{}) (interface{}, error) {
+			panic("boom")
+		})
+	}()
+
+	a, err = r.call(task, nil)
+
+	if err != nil || a.Errno != ERRNO_OK || r.calls != 1 {
+		t.Fatalf("retry after release %v %v calls %d", a, err, r.calls)
+	}
+}
+
+func TestIdempotencyLease(t *testing.T) {
+
+	r := newIdempotencyTest()
+
+	task := &pb.AppCreateTask{Title: "demo", IdempotencyKey: "k3"}
+
+	hash, _ := requestHash(task)
+
+	now := time.Now()
+
+	r.app.Store.Idempotency().Create(r.c, bson.D{bson.E{"_id", r.key("k3")},
+		bson.E{"hash", hash},
+		bson.E{"etime", now.Add(time.Hour)},
+		bson.E{"lease", now.Add(time.Minute)},
+		bson.E{"rev", int32(1)}})
+
+	a, err := r.call(task, nil)
+
+	if err != nil || a.Errno != ERRNO_CONFLICT || r.calls != 0 {
+		t.Fatalf("in progress %v %v", a, err)
+	}
+
+	r.app.Store.Idempotency().Update(r.c, r.key("k3"), &Update{Set: bson.D{bson.E{"lease", now.Add(-time.Second)}}})
+
+	a, err = r.call(task, nil)
+
+	if err != nil || a.Errno != ERRNO_OK || r.calls != 1 {
+		t.Fatalf("takeover %v %v", a, err)
+	}
+
+	b, _ := r.call(task, nil)
+
+	if b.Data.Id != a.Data.Id || r.calls != 1 {
+		t.Fatalf("replay after takeover %v", b)
+	}
+}
+
+/**
+* 过期的幂等键直接替换，不要求客户端重试
+**/
+func TestIdempotencyExpired(t *testing.T) {
+
+	r := newIdempotencyTest()
+
+	r.app.Store.Idempotency().Create(r.c, bson.D{bson.E{"_id", r.key("k4")},
+		bson.E{"hash", "other"},
+		bson.E{"etime", time.Now().Add(-time.Second)},
+		bson.E{"result", "x"},
+		bson.E{"rev", int32(1)}})
+
+	a, err := r.call(&pb.AppCreateTask{Title: "demo", IdempotencyKey: "k4"}, nil)
+
+	if err != nil || a.Errno != ERRNO_OK || r.calls != 1 {
+		t.Fatalf("expired key %v %v", a, err)
+	}
+}
+
+func TestIdempotencyStatusError(t *testing.T) {
+
+	r := newIdempotencyTest()
+
+	grpc.GetContext(r.c).SetValue(STATUS_ERROR, "true")
+
+	task := &pb.AppCreateTask{Title: "demo", IdempotencyKey: "k5"}
+
+	r.call(task, nil)
+
+	_, err := r.call(&pb.AppCreateTask{Title: "other", IdempotencyKey: "k5"}, nil)
+
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("status error %v", err)
+	}
+
+	_, err = r.call(&pb.AppCreateTask{Title: "demo", IdempotencyKey: string(make([]byte, IDEMPOTENCY_KEY_MAX_LENGTH+1))}, nil)
+
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("status error %v", err)
+	}
+
+	grpc.GetContext(r.c).SetValue(STATUS_ERROR, "false")
+
+	a, err := r.call(&pb.AppCreateTask{Title: "other", IdempotencyKey: "k5"}, nil)
+
+	if err != nil || a.Errno != ERRNO_CONFLICT {
+		t.Fatalf("errno result %v %v", a, err)
+	}
+}
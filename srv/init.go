@@ -8,4 +8,7 @@ func init() {
 	micro.Reg("uv-app", func(name string, config interface{}) (micro.Service, error) {
 		return newAppService(name, config), nil
 	})
+	micro.Reg("uv-fs-oss", func(name string, config interface{}) (micro.Service, error) {
+		return newFSOSSService(name, config), nil
+	})
 }
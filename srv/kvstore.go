@@ -0,0 +1,1337 @@
+package srv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+/**
+* 键值存储，value 为 bson 编码的文档，Write 须原子写入整批
+**/
+type kv interface {
+	Get(key []byte) ([]byte, error)
+	Each(prefix []byte, fn func(key []byte, value []byte) bool) error
+	Write(batch []kvWrite) error
+}
+
+/**
+* 批量写入项，Value 为 nil 时删除
+**/
+type kvWrite struct {
+	Key   []byte
+	Value []byte
+}
+
+/**
+* 基于键值存储的后端，写操作在事务中串行执行，事务内的写入暂存在 kvTx 中，提交时整批写入
+* 提交的变更同时记入内存中的变更日志供 watch 使用，日志只保留最近 kvChangeLogSize 条，重启后清空
+**/
+type kvBackend struct {
+	lock    sync.Mutex
+	db      kv
+	wlock   sync.Mutex
+	epoch   string
+	seq     int64
+	changes []*kvChange
+	notify  chan struct{}
+}
+
+const kvChangeLogSize = 4096
+
+type kvChange struct {
+	seq int64
+	e   *ChangeEvent
+}
+
+type kvTx struct {
+	writes map[string][]byte
+	keys   []string
+}
+
+type kvTxKey struct{}
+
+/**
+* 基于键值存储的集合，唯一键编码为存储键，查询时在内存中按 mongo 查询条件匹配
+**/
+type kvCollection struct {
+	b    *kvBackend
+	name string
+	keys []string
+}
+
+type kvItem struct {
+	key []byte
+	doc bson.M
+}
+
+func newKVStore(db kv) Store {
+	return newStore(&kvBackend{db: db,
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		notify: make(chan struct{})})
+}
+
+func (s *kvBackend) collection(name string, keys []string) Collection {
+	return &kvCollection{b: s, name: name, keys: keys}
+}
+
+func getKVTx(c context.Context) *kvTx {
+	tx, _ := c.Value(kvTxKey{}).(*kvTx)
+	return tx
+}
+
+func (s *kvBackend) transaction(c context.Context, fn func(c context.Context) error) error {
+
+	if getKVTx(c) != nil {
+		return fn(c)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx := &kvTx{writes: map[string][]byte{}}
+
+	err := fn(context.WithValue(c, kvTxKey{}, tx))
+
+	if err != nil {
+		return err
+	}
+
+	if len(tx.keys) == 0 {
+		return nil
+	}
+
+	batch := []kvWrite{}
+	changes := []*ChangeEvent{}
+
+	for _, key := range tx.keys {
+
+		value := tx.writes[key]
+
+		batch = append(batch, kvWrite{Key: []byte(key), Value: value})
+
+		old, err := s.db.Get([]byte(key))
+
+		if err != nil {
+			return err
+		}
+
+		e, err := kvChangeEvent(key, old, value)
+
+		if err != nil {
+			return err
+		}
+
+		if e != nil {
+			changes = append(changes, e)
+		}
+	}
+
+	err = s.db.Write(batch)
+
+	if err != nil {
+		return err
+	}
+
+	s.publish(changes)
+
+	return nil
+}
+
+/**
+* 由存储键的新旧值生成变更事件，值未变化时返回 nil
+**/
+func kvChangeEvent(key string, old []byte, value []byte) (*ChangeEvent, error) {
+
+	if old == nil && value == nil {
+		return nil, nil
+	}
+
+	if old != nil && value != nil && bytes.Equal(old, value) {
+		return nil, nil
+	}
+
+	e := &ChangeEvent{Coll: key[:strings.IndexByte(key, 0)]}
+
+	if old != nil {
+		err := bson.Unmarshal(old, &e.Before)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if value != nil {
+		err := bson.Unmarshal(value, &e.Doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case old == nil:
+		e.Type = "insert"
+		e.Id = e.Doc["_id"]
+	case value == nil:
+		e.Type = "delete"
+		e.Id = e.Before["_id"]
+	default:
+		e.Type = "update"
+		e.Id = e.Doc["_id"]
+	}
+
+	return e, nil
+}
+
+/**
+* 记入变更日志并唤醒监听
+**/
+func (s *kvBackend) publish(changes []*ChangeEvent) {
+
+	if len(changes) == 0 {
+		return
+	}
+
+	s.wlock.Lock()
+	defer s.wlock.Unlock()
+
+	for _, e := range changes {
+		s.seq = s.seq + 1
+		e.Token = s.epoch + "." + strconv.FormatInt(s.seq, 36)
+		s.changes = append(s.changes, &kvChange{seq: s.seq, e: e})
+	}
+
+	if n := len(s.changes) - kvChangeLogSize; n > 0 {
+		s.changes = append([]*kvChange{}, s.changes[n:]...)
+	}
+
+	close(s.notify)
+
+	s.notify = make(chan struct{})
+}
+
+/**
+* 读取 seq 之后的变更，日志已不包含 seq 之后的第一条时返回 ErrResume
+**/
+func (s *kvBackend) changesAfter(seq int64) ([]*kvChange, chan struct{}, error) {
+
+	s.wlock.Lock()
+	defer s.wlock.Unlock()
+
+	if seq > s.seq {
+		return nil, nil, ErrResume
+	}
+
+	if seq == s.seq {
+		return nil, s.notify, nil
+	}
+
+	first := s.changes[0].seq
+
+	if seq+1 < first {
+		return nil, nil, ErrResume
+	}
+
+	return s.changes[seq+1-first:], s.notify, nil
+}
+
+func (s *kvBackend) resumeSeq(token string) (int64, error) {
+
+	if token == "" {
+		s.wlock.Lock()
+		defer s.wlock.Unlock()
+		return s.seq, nil
+	}
+
+	i := strings.LastIndexByte(token, '.')
+
+	if i < 0 || token[:i] != s.epoch {
+		return 0, ErrResume
+	}
+
+	seq, err := strconv.ParseInt(token[i+1:], 36, 64)
+
+	if err != nil || seq < 0 {
+		return 0, ErrResume
+	}
+
+	return seq, nil
+}
+
+/**
+* 基于变更日志监听，删除事件携带删除前的文档，条件按变更后(删除时按删除前)的文档匹配
+**/
+func (s *kvBackend) watch(c context.Context, filters map[string]bson.D, token string, fn func(e *ChangeEvent) error) error {
+
+	seq, err := s.resumeSeq(token)
+
+	if err != nil {
+		return err
+	}
+
+	for {
+
+		items, notify, err := s.changesAfter(seq)
+
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+
+			seq = item.seq
+
+			filter, ok := filters[item.e.Coll]
+
+			if !ok {
+				continue
+			}
+
+			doc := item.e.Doc
+
+			if doc == nil {
+				doc = item.e.Before
+			}
+
+			ok, err = matchDoc(doc, filter)
+
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				continue
+			}
+
+			err = fn(item.e)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(items) > 0 {
+			continue
+		}
+
+		select {
+		case <-c.Done():
+			return nil
+		case <-notify:
+		}
+	}
+}
+
+func (tx *kvTx) put(key []byte, value []byte) {
+	k := string(key)
+	if _, ok := tx.writes[k]; !ok {
+		tx.keys = append(tx.keys, k)
+	}
+	tx.writes[k] = value
+}
+
+func (s *kvBackend) get(c context.Context, key []byte) ([]byte, error) {
+
+	if tx := getKVTx(c); tx != nil {
+		if v, ok := tx.writes[string(key)]; ok {
+			return v, nil
+		}
+	}
+
+	return s.db.Get(key)
+}
+
+/**
+* 按前缀有序遍历，事务中合并未提交的写入
+**/
+func (s *kvBackend) each(c context.Context, prefix []byte, fn func(key []byte, value []byte) bool) error {
+
+	tx := getKVTx(c)
+
+	if tx == nil || len(tx.keys) == 0 {
+		return s.db.Each(prefix, fn)
+	}
+
+	items := map[string][]byte{}
+	keys := []string{}
+
+	err := s.db.Each(prefix, func(key []byte, value []byte) bool {
+		keys = append(keys, string(key))
+		items[string(key)] = value
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, key := range tx.keys {
+		if !strings.HasPrefix(key, string(prefix)) {
+			continue
+		}
+		if _, ok := items[key]; !ok {
+			keys = append(keys, key)
+		}
+		items[key] = tx.writes[key]
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if items[key] == nil {
+			continue
+		}
+		if !fn([]byte(key), items[key]) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *kvCollection) prefix() []byte {
+	return []byte(s.name + "\x00")
+}
+
+/**
+* 唯一键字段值，支持字符串及 ObjectID
+**/
+func kvKeyValue(v interface{}) (string, bool) {
+	switch r := v.(type) {
+	case string:
+		return r, true
+	case primitive.ObjectID:
+		return r.Hex(), true
+	}
+	return "", false
+}
+
+func (s *kvCollection) key(get func(name string) (interface{}, bool)) ([]byte, bool) {
+
+	b := bytes.NewBuffer(s.prefix())
+
+	for i, name := range s.keys {
+
+		v, ok := get(name)
+
+		if !ok {
+			return nil, false
+		}
+
+		k, ok := kvKeyValue(v)
+
+		if !ok {
+			return nil, false
+		}
+
+		if i > 0 {
+			b.WriteByte(0)
+		}
+
+		b.WriteString(k)
+	}
+
+	return b.Bytes(), true
+}
+
+/**
+* 文档的存储键，唯一键字段须为字符串或 ObjectID
+**/
+func (s *kvCollection) docKey(rs bson.M) ([]byte, error) {
+
+	k, ok := s.key(func(name string) (interface{}, bool) {
+		v, ok := rs[name]
+		return v, ok
+	})
+
+	if !ok {
+		return nil, fmt.Errorf("%s key %s must be string", s.name, strings.Join(s.keys, ","))
+	}
+
+	return k, nil
+}
+
+/**
+* 查询条件中包含全部唯一键的等值条件时，直接按存储键读取
+**/
+func (s *kvCollection) filterKey(filter bson.D) ([]byte, bool) {
+	return s.key(func(name string) (interface{}, bool) {
+		return lookupD(filter, name)
+	})
+}
+
+func (s *kvCollection) load(c context.Context, key []byte) (bson.M, error) {
+
+	b, err := s.b.get(c, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if b == nil {
+		return nil, ErrNotFound
+	}
+
+	var rs bson.M
+
+	err = bson.Unmarshal(b, &rs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+/**
+* 写入事务，须在 transaction 中调用
+**/
+func (s *kvCollection) save(c context.Context, key []byte, doc interface{}) (bson.M, error) {
+
+	b, err := bson.Marshal(doc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	getKVTx(c).put(key, b)
+
+	var rs bson.M
+
+	err = bson.Unmarshal(b, &rs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *kvCollection) delete(c context.Context, key []byte) {
+	getKVTx(c).put(key, nil)
+}
+
+/**
+* 遍历匹配条件的文档，fn 返回 false 时停止
+**/
+func (s *kvCollection) scan(c context.Context, filter bson.D, fn func(item *kvItem) bool) error {
+
+	var err error
+
+	e := s.b.each(c, s.prefix(), func(key []byte, value []byte) bool {
+
+		var rs bson.M
+
+		err = bson.Unmarshal(value, &rs)
+
+		if err != nil {
+			return false
+		}
+
+		var ok bool
+
+		ok, err = matchDoc(rs, filter)
+
+		if err != nil {
+			return false
+		}
+
+		if ok {
+			return fn(&kvItem{key: key, doc: rs})
+		}
+
+		return true
+	})
+
+	if e != nil {
+		return e
+	}
+
+	return err
+}
+
+func (s *kvCollection) findAll(c context.Context, filter bson.D) ([]*kvItem, error) {
+
+	items := []*kvItem{}
+
+	err := s.scan(c, filter, func(item *kvItem) bool {
+		items = append(items, item)
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+/**
+* 查找第一个匹配的文档
+**/
+func (s *kvCollection) lookup(c context.Context, filter bson.D) (*kvItem, error) {
+
+	if k, ok := s.filterKey(filter); ok {
+
+		rs, err := s.load(c, k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := matchDoc(rs, filter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, ErrNotFound
+		}
+
+		return &kvItem{key: k, doc: rs}, nil
+	}
+
+	var rs *kvItem
+
+	err := s.scan(c, filter, func(item *kvItem) bool {
+		rs = item
+		return false
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rs == nil {
+		return nil, ErrNotFound
+	}
+
+	return rs, nil
+}
+
+func (s *kvCollection) Get(c context.Context, filter bson.D) (bson.M, error) {
+
+	item, err := s.lookup(c, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return item.doc, nil
+}
+
+func (s *kvCollection) Find(c context.Context, filter bson.D, sortKeys bson.D, limit int64) ([]bson.M, error) {
+
+	items, err := s.findAll(c, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rs := []bson.M{}
+
+	for _, item := range items {
+		rs = append(rs, item.doc)
+	}
+
+	if len(sortKeys) > 0 {
+		sort.SliceStable(rs, func(i, j int) bool {
+			return compareSort(rs[i], rs[j], sortKeys) < 0
+		})
+	}
+
+	if limit > 0 && int64(len(rs)) > limit {
+		rs = rs[:limit]
+	}
+
+	return rs, nil
+}
+
+func (s *kvCollection) Count(c context.Context, filter bson.D) (int64, error) {
+
+	var n int64 = 0
+
+	err := s.scan(c, filter, func(item *kvItem) bool {
+		n = n + 1
+		return true
+	})
+
+	return n, err
+}
+
+func (s *kvCollection) Query(c context.Context, q *Query) (*QueryResult, error) {
+
+	n := q.N
+
+	if n < 1 {
+		n = 20
+	}
+
+	sortKeys := q.sort()
+
+	filter, err := q.filter()
+
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.Find(c, filter, sortKeys, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &QueryResult{}
+
+	if q.P > 0 || q.Count {
+		rs.Page = newPage(q.P, n, int64(len(items)))
+	}
+
+	if q.Cursor != "" {
+
+		values, err := decodeCursor(sortKeys, q.Cursor)
+
+		if err != nil {
+			return nil, err
+		}
+
+		last := bson.M{}
+
+		for i, e := range sortKeys {
+			last[e.Key] = values[i]
+		}
+
+		i := sort.Search(len(items), func(i int) bool {
+			return compareSort(last, items[i], sortKeys) < 0
+		})
+
+		items = items[i:]
+	}
+
+	if q.P > 0 {
+		skip := int(n * (q.P - 1))
+		if skip > len(items) {
+			skip = len(items)
+		}
+		items = items[skip:]
+	}
+
+	if len(items) > int(n) {
+		items = items[:n]
+	}
+
+	rs.Items = items
+
+	rs.Cursor, err = nextCursor(sortKeys, rs.Items, n)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+/**
+* 写入文档，存储键变化或新增时校验唯一键，old 为 nil 表示新增
+**/
+func (s *kvCollection) put(c context.Context, old []byte, rs bson.M) (bson.M, error) {
+
+	k, err := s.docKey(rs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil || !bytes.Equal(old, k) {
+
+		b, err := s.b.get(c, k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if b != nil {
+			return nil, ErrDuplicate
+		}
+
+		if old != nil {
+			s.delete(c, old)
+		}
+	}
+
+	return s.save(c, k, rs)
+}
+
+func (s *kvCollection) Create(c context.Context, doc bson.D) error {
+
+	if _, ok := lookupD(doc, "_id"); !ok {
+		doc = append(bson.D{bson.E{"_id", primitive.NewObjectID()}}, doc...)
+	}
+
+	return s.b.transaction(c, func(c context.Context) error {
+
+		rs := bson.M{}
+
+		for _, e := range doc {
+			rs[e.Key] = e.Value
+		}
+
+		_, err := s.put(c, nil, rs)
+
+		return err
+	})
+}
+
+/**
+* 按 Update 修改文档
+**/
+func (u *Update) apply(rs bson.M) {
+
+	for _, e := range u.Set {
+		setPath(rs, e.Key, e.Value)
+	}
+
+	for _, e := range u.Inc {
+		v, _ := getPath(rs, e.Key)
+		setPath(rs, e.Key, addNumber(v, e.Value))
+	}
+
+	for _, e := range u.Push {
+		v, _ := getPath(rs, e.Key)
+		vs, _ := v.(bson.A)
+		setPath(rs, e.Key, append(vs, e.Value))
+	}
+
+	if !u.KeepRev {
+		rs["rev"] = int32(dynamic.IntValue(rs["rev"], 0)) + 1
+	}
+}
+
+/**
+* 数值相加，均为 int32 时结果为 int32
+**/
+func addNumber(a interface{}, b interface{}) interface{} {
+
+	if a == nil {
+		return b
+	}
+
+	switch x := a.(type) {
+	case int32:
+		if y, ok := b.(int32); ok {
+			return x + y
+		}
+	case float64:
+		return x + dynamic.FloatValue(b, 0)
+	}
+
+	if _, ok := b.(float64); ok {
+		return dynamic.FloatValue(a, 0) + b.(float64)
+	}
+
+	return dynamic.IntValue(a, 0) + dynamic.IntValue(b, 0)
+}
+
+func (s *kvCollection) Update(c context.Context, filter bson.D, u *Update) (bson.M, error) {
+
+	var rs bson.M
+
+	err := s.b.transaction(c, func(c context.Context) error {
+
+		item, err := s.lookup(c, filter)
+
+		var old []byte
+
+		if err == ErrNotFound {
+
+			if !u.Upsert || u.Rev > 0 {
+				return ErrNotFound
+			}
+
+			doc := bson.M{}
+
+			for _, e := range filter {
+				if _, ok := e.Value.(bson.D); ok || strings.HasPrefix(e.Key, "$") {
+					continue
+				}
+				setPath(doc, e.Key, e.Value)
+			}
+
+			if _, ok := doc["_id"]; !ok {
+				doc["_id"] = primitive.NewObjectID()
+			}
+
+			for _, e := range u.SetOnInsert {
+				setPath(doc, e.Key, e.Value)
+			}
+
+			item = &kvItem{doc: doc}
+
+		} else if err != nil {
+			return err
+		} else if u.Rev > 0 && int32(dynamic.IntValue(item.doc["rev"], 0)) != u.Rev {
+			return ErrConflict
+		} else {
+			old = item.key
+		}
+
+		u.apply(item.doc)
+
+		rs, err = s.put(c, old, item.doc)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *kvCollection) UpdateMany(c context.Context, filter bson.D, u *Update) (int64, error) {
+
+	var n int64 = 0
+
+	err := s.b.transaction(c, func(c context.Context) error {
+
+		items, err := s.findAll(c, filter)
+
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+
+			u.apply(item.doc)
+
+			_, err = s.put(c, item.key, item.doc)
+
+			if err != nil {
+				return err
+			}
+
+			n = n + 1
+		}
+
+		return nil
+	})
+
+	return n, err
+}
+
+func (s *kvCollection) Remove(c context.Context, filter bson.D, rev int32) (bson.M, error) {
+
+	var rs bson.M
+
+	err := s.b.transaction(c, func(c context.Context) error {
+
+		item, err := s.lookup(c, filter)
+
+		if err != nil {
+			return err
+		}
+
+		if rev > 0 && int32(dynamic.IntValue(item.doc["rev"], 0)) != rev {
+			return ErrConflict
+		}
+
+		s.delete(c, item.key)
+
+		rs = item.doc
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *kvCollection) RemoveMany(c context.Context, filter bson.D) ([]bson.M, error) {
+
+	rs := []bson.M{}
+
+	err := s.b.transaction(c, func(c context.Context) error {
+
+		items, err := s.findAll(c, filter)
+
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			s.delete(c, item.key)
+			rs = append(rs, item.doc)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func lookupD(doc bson.D, key string) (interface{}, bool) {
+	for _, e := range doc {
+		if e.Key == key {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
+
+/**
+* 按 a.b 形式的路径设置字段，中间字段不存在时创建
+**/
+func setPath(rs bson.M, path string, value interface{}) {
+
+	keys := strings.Split(path, ".")
+
+	for _, key := range keys[:len(keys)-1] {
+		v, ok := rs[key].(bson.M)
+		if !ok {
+			v = bson.M{}
+			rs[key] = v
+		}
+		rs = v
+	}
+
+	rs[keys[len(keys)-1]] = value
+}
+
+/**
+* 按 a.b 形式的路径读取字段
+**/
+func getPath(rs bson.M, path string) (interface{}, bool) {
+
+	var v interface{} = rs
+
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(bson.M)
+		if !ok {
+			return nil, false
+		}
+		v, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+/**
+* 匹配 mongo 查询条件，仅支持 Query 生成的操作符
+**/
+func matchDoc(rs bson.M, filter bson.D) (bool, error) {
+
+	for _, e := range filter {
+
+		switch e.Key {
+		case "$and", "$or":
+
+			vs, ok := e.Value.(bson.A)
+
+			if !ok {
+				return false, fmt.Errorf("%s must be array", e.Key)
+			}
+
+			matched := e.Key == "$and"
+
+			for _, v := range vs {
+
+				sub, ok := v.(bson.D)
+
+				if !ok {
+					return false, fmt.Errorf("%s item must be document", e.Key)
+				}
+
+				r, err := matchDoc(rs, sub)
+
+				if err != nil {
+					return false, err
+				}
+
+				if e.Key == "$and" && !r {
+					matched = false
+					break
+				}
+
+				if e.Key == "$or" && r {
+					matched = true
+					break
+				}
+			}
+
+			if !matched {
+				return false, nil
+			}
+
+		case "$text":
+
+			text, _ := e.Value.(bson.D)
+			search, _ := lookupD(text, "$search")
+
+			if !matchText(dynamic.StringValue(rs["title"], ""), dynamic.StringValue(search, "")) {
+				return false, nil
+			}
+
+		default:
+
+			v, ok := getPath(rs, e.Key)
+
+			r, err := matchField(v, ok, e.Value)
+
+			if err != nil {
+				return false, err
+			}
+
+			if !r {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func matchField(v interface{}, exists bool, cond interface{}) (bool, error) {
+
+	ops, ok := cond.(bson.D)
+
+	if !ok || len(ops) == 0 || !strings.HasPrefix(ops[0].Key, "$") {
+		return equalValue(v, cond), nil
+	}
+
+	for _, op := range ops {
+
+		r := true
+
+		switch op.Key {
+		case "$eq":
+			r = equalValue(v, op.Value)
+		case "$ne":
+			r = !equalValue(v, op.Value)
+		case "$gt", "$gte", "$lt", "$lte":
+			r = exists && matchCompare(v, op.Key, op.Value)
+		case "$in", "$nin":
+			r = false
+			dynamic.Each(op.Value, func(_ interface{}, item interface{}) bool {
+				r = equalValue(v, item)
+				return !r
+			})
+			if op.Key == "$nin" {
+				r = !r
+			}
+		case "$exists":
+			r = exists == (op.Value == true)
+		case "$regex":
+			options, _ := lookupD(ops, "$options")
+			pattern := dynamic.StringValue(op.Value, "")
+			if strings.Contains(dynamic.StringValue(options, ""), "i") {
+				pattern = "(?i)" + pattern
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return false, err
+			}
+			s, ok := v.(string)
+			r = ok && re.MatchString(s)
+		case "$options":
+		default:
+			return false, fmt.Errorf("unsupported operator %s", op.Key)
+		}
+
+		if !r {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchCompare(v interface{}, op string, value interface{}) bool {
+
+	if typeRank(v) != typeRank(value) {
+		return false
+	}
+
+	r := compareValue(v, value)
+
+	switch op {
+	case "$gt":
+		return r > 0
+	case "$gte":
+		return r >= 0
+	case "$lt":
+		return r < 0
+	}
+
+	return r <= 0
+}
+
+/**
+* 相等比较，数组字段任一元素相等即匹配
+**/
+func equalValue(v interface{}, value interface{}) bool {
+
+	if vs, ok := v.(bson.A); ok {
+		if _, ok := value.(bson.A); !ok {
+			for _, item := range vs {
+				if equalValue(item, value) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	return typeRank(v) == typeRank(value) && compareValue(v, value) == 0
+}
+
+/**
+* 全文检索近似实现，标题中包含任一检索词即匹配，忽略大小写
+**/
+func matchText(title string, search string) bool {
+
+	words := map[string]bool{}
+
+	for _, w := range strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[w] = true
+	}
+
+	for _, w := range strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if words[w] {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+* 与 mongo 一致的类型排序
+**/
+func typeRank(v interface{}) int {
+	switch v.(type) {
+	case nil, primitive.Null, primitive.Undefined:
+		return 1
+	case int, int32, int64, float32, float64:
+		return 2
+	case string:
+		return 3
+	case bson.M, bson.D:
+		return 4
+	case bson.A:
+		return 5
+	case primitive.Binary:
+		return 6
+	case primitive.ObjectID:
+		return 7
+	case bool:
+		return 8
+	case primitive.DateTime:
+		return 9
+	}
+	return 10
+}
+
+func compareValue(a interface{}, b interface{}) int {
+
+	ra := typeRank(a)
+	rb := typeRank(b)
+
+	if ra != rb {
+		if ra < rb {
+			return -1
+		}
+		return 1
+	}
+
+	switch ra {
+	case 2:
+		x := dynamic.FloatValue(a, 0)
+		y := dynamic.FloatValue(b, 0)
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	case 3:
+		return strings.Compare(a.(string), b.(string))
+	case 7:
+		x := a.(primitive.ObjectID)
+		y := b.(primitive.ObjectID)
+		return bytes.Compare(x[:], y[:])
+	case 8:
+		x := a.(bool)
+		y := b.(bool)
+		if x == y {
+			return 0
+		} else if !x {
+			return -1
+		}
+		return 1
+	case 9:
+		x := a.(primitive.DateTime)
+		y := b.(primitive.DateTime)
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	case 1:
+		return 0
+	}
+
+	x, _ := bson.Marshal(bson.M{"v": a})
+	y, _ := bson.Marshal(bson.M{"v": b})
+
+	return bytes.Compare(x, y)
+}
+
+/**
+* 按排序字段比较，返回值小于 0 时 a 排在 b 之前
+**/
+func compareSort(a bson.M, b bson.M, sortKeys bson.D) int {
+
+	for _, e := range sortKeys {
+
+		x, _ := getPath(a, e.Key)
+		y, _ := getPath(b, e.Key)
+
+		r := compareValue(x, y)
+
+		if r != 0 {
+			if dynamic.IntValue(e.Value, 1) < 0 {
+				return -r
+			}
+			return r
+		}
+	}
+
+	return 0
+}
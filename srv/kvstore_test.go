@@ -0,0 +1,297 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMatchDoc(t *testing.T) {
+
+	rs := bson.M{
+		"_id":   "a1",
+		"title": "Hello World app",
+		"n":     int32(5),
+		"tags":  bson.A{"x", "y"},
+		"info":  bson.M{"owner": "bob"},
+	}
+
+	cases := []struct {
+		name   string
+		filter bson.D
+		ok     bool
+	}{
+		{"eq", bson.D{bson.E{"_id", "a1"}}, true},
+		{"eq path", bson.D{bson.E{"info.owner", "bob"}}, true},
+		{"eq array", bson.D{bson.E{"tags", "y"}}, true},
+		{"ne", bson.D{bson.E{"n", bson.D{bson.E{"$ne", 5}}}}, false},
+		{"gt number types", bson.D{bson.E{"n", bson.D{bson.E{"$gt", int64(4)}}}}, true},
+		{"lte", bson.D{bson.E{"n", bson.D{bson.E{"$lte", 4.5}}}}, false},
+		{"in", bson.D{bson.E{"_id", bson.D{bson.E{"$in", bson.A{"a0", "a1"}}}}}, true},
+		{"in miss", bson.D{bson.E{"_id", bson.D{bson.E{"$in", bson.A{"a0"}}}}}, false},
+		{"nin", bson.D{bson.E{"_id", bson.D{bson.E{"$nin", bson.A{"a0"}}}}}, true},
+		{"nin hit", bson.D{bson.E{"tags", bson.D{bson.E{"$nin", bson.A{"x"}}}}}, false},
+		{"exists", bson.D{bson.E{"info.owner", bson.D{bson.E{"$exists", true}}}}, true},
+		{"not exists", bson.D{bson.E{"secret", bson.D{bson.E{"$exists", false}}}}, true},
+		{"exists miss", bson.D{bson.E{"secret", bson.D{bson.E{"$exists", true}}}}, false},
+		{"regex", bson.D{bson.E{"title", bson.D{bson.E{"$regex", "^Hello"}}}}, true},
+		{"regex case", bson.D{bson.E{"title", bson.D{bson.E{"$regex", "^hello"}}}}, false},
+		{"regex options", bson.D{bson.E{"title", bson.D{bson.E{"$regex", "^hello"}, bson.E{"$options", "i"}}}}, true},
+		{"and", bson.D{bson.E{"$and", bson.A{bson.D{bson.E{"_id", "a1"}}, bson.D{bson.E{"n", 5}}}}}, true},
+		{"and miss", bson.D{bson.E{"$and", bson.A{bson.D{bson.E{"_id", "a1"}}, bson.D{bson.E{"n", 6}}}}}, false},
+		{"or", bson.D{bson.E{"$or", bson.A{bson.D{bson.E{"_id", "a0"}}, bson.D{bson.E{"n", 5}}}}}, true},
+		{"or miss", bson.D{bson.E{"$or", bson.A{bson.D{bson.E{"_id", "a0"}}, bson.D{bson.E{"n", 6}}}}}, false},
+		{"text", bson.D{bson.E{"$text", bson.D{bson.E{"$search", "world"}}}}, true},
+		{"text miss", bson.D{bson.E{"$text", bson.D{bson.E{"$search", "wor"}}}}, false},
+	}
+
+	for _, e := range cases {
+		ok, err := matchDoc(rs, e.filter)
+		if err != nil {
+			t.Fatalf("%s: %v", e.name, err)
+		}
+		if ok != e.ok {
+			t.Errorf("%s: got %v want %v", e.name, ok, e.ok)
+		}
+	}
+
+	_, err := matchDoc(rs, bson.D{bson.E{"n", bson.D{bson.E{"$where", "1"}}}})
+
+	if err == nil {
+		t.Errorf("unsupported operator must fail")
+	}
+}
+
+func TestCompareSort(t *testing.T) {
+
+	items := []bson.M{
+		{"_id": "b", "ctime": int32(2)},
+		{"_id": "a", "ctime": int32(2)},
+		{"_id": "c", "ctime": int32(1)},
+		{"_id": "d"},
+	}
+
+	coll := newMemoryStore().Collection("app")
+	c := context.Background()
+
+	for _, item := range items {
+		doc := bson.D{}
+		for k, v := range item {
+			doc = append(doc, bson.E{k, v})
+		}
+		err := coll.Create(c, doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		sort bson.D
+		ids  string
+	}{
+		{bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}, "bacd"},
+		{bson.D{bson.E{"ctime", 1}, bson.E{"_id", 1}}, "dcab"},
+		{bson.D{bson.E{"_id", 1}}, "abcd"},
+	}
+
+	for _, e := range cases {
+
+		rs, err := coll.Find(c, bson.D{}, e.sort, 0)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids := ""
+
+		for _, item := range rs {
+			ids = ids + item["_id"].(string)
+		}
+
+		if ids != e.ids {
+			t.Errorf("sort %v: got %s want %s", e.sort, ids, e.ids)
+		}
+	}
+}
+
+/**
+* 游标分页与一次性排序结果一致，ctime 相同时按 _id 区分
+**/
+func TestQueryCursorParity(t *testing.T) {
+
+	coll := newMemoryStore().Collection("app")
+	c := context.Background()
+
+	for i := 0; i < 10; i++ {
+		err := coll.Create(c, bson.D{bson.E{"_id", fmt.Sprintf("a%02d", i)}, bson.E{"ctime", int32(i / 3)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := coll.Find(c, bson.D{}, bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []int32{1, 3, 4, 10} {
+
+		ids := []interface{}{}
+		cursor := ""
+
+		for {
+
+			rs, err := coll.Query(c, &Query{N: n, Cursor: cursor})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, item := range rs.Items {
+				ids = append(ids, item["_id"])
+			}
+
+			if rs.Cursor == "" {
+				break
+			}
+
+			cursor = rs.Cursor
+		}
+
+		if len(ids) != len(all) {
+			t.Fatalf("n %d: got %d items want %d", n, len(ids), len(all))
+		}
+
+		for i, item := range all {
+			if ids[i] != item["_id"] {
+				t.Fatalf("n %d: item %d got %v want %v", n, i, ids[i], item["_id"])
+			}
+		}
+	}
+
+	rs, err := coll.Query(c, &Query{N: 4, P: 3})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rs.Items) != 2 || rs.Items[0]["_id"] != all[8]["_id"] || rs.Page.Count != 3 || rs.Page.TotalCount != 10 {
+		t.Fatalf("page 3 %v %v", rs.Items, rs.Page)
+	}
+
+	_, err = coll.Query(c, &Query{N: 4, Cursor: "bad"})
+
+	if err == nil {
+		t.Fatalf("invalid cursor must fail")
+	}
+}
+
+func TestKVTransaction(t *testing.T) {
+
+	s := newMemoryStore()
+	c := context.Background()
+
+	err := s.Transaction(c, func(c context.Context) error {
+		err := s.App().Create(c, bson.D{bson.E{"_id", "a1"}})
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("rollback")
+	})
+
+	if err == nil {
+		t.Fatalf("transaction must fail")
+	}
+
+	_, err = s.App().Get(c, "a1")
+
+	if err != ErrNotFound {
+		t.Fatalf("rolled back write visible %v", err)
+	}
+}
+
+func TestKVWatch(t *testing.T) {
+
+	s := newMemoryStore()
+	c := context.Background()
+
+	s.Ac().Create(c, bson.D{bson.E{"cid", "c0"}, bson.E{"appid", "a0"}, bson.E{"ver", "1.0.0"}})
+
+	filters := map[string]bson.D{"ac": {bson.E{"cid", "c1"}}}
+
+	events := make(chan *ChangeEvent, 16)
+	wc, cancel := context.WithCancel(c)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- s.Watch(wc, filters, "", func(e *ChangeEvent) error {
+			events <- e
+			return nil
+		})
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	s.Ac().Create(c, bson.D{bson.E{"cid", "c1"}, bson.E{"appid", "a1"}, bson.E{"ver", "1.0.0"}, bson.E{"rev", int32(1)}})
+	s.Ac().Create(c, bson.D{bson.E{"cid", "c2"}, bson.E{"appid", "a1"}, bson.E{"ver", "1.0.0"}})
+	s.Ac().Update(c, "c1", "a1", &Update{Set: bson.D{bson.E{"ver", "1.1.0"}}})
+	s.Ac().Remove(c, "c1", "a1", 0)
+
+	types := []string{"insert", "update", "delete"}
+	received := []*ChangeEvent{}
+
+	for _, typ := range types {
+		select {
+		case e := <-events:
+			if e.Type != typ || e.Coll != "ac" {
+				t.Fatalf("got %s %s want %s", e.Type, e.Coll, typ)
+			}
+			received = append(received, e)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout waiting for %s", typ)
+		}
+	}
+
+	if received[2].Doc != nil || received[2].Before["cid"] != "c1" || received[2].Before["ver"] != "1.1.0" {
+		t.Fatalf("delete pre-image %v", received[2].Before)
+	}
+
+	cancel()
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	/* 断线期间的删除在恢复后可见 */
+
+	resumed := []*ChangeEvent{}
+
+	rc, cancel := context.WithTimeout(c, 50*time.Millisecond)
+	defer cancel()
+
+	err := s.Watch(rc, filters, received[0].Token, func(e *ChangeEvent) error {
+		resumed = append(resumed, e)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resumed) != 2 || resumed[0].Type != "update" || resumed[1].Type != "delete" || resumed[1].Before["appid"] != "a1" {
+		t.Fatalf("resume %v", resumed)
+	}
+
+	for _, token := range []string{"x", "x.1", received[0].Token + "zz"} {
+		err = s.Watch(c, filters, token, func(e *ChangeEvent) error {
+			return nil
+		})
+		if err != ErrResume {
+			t.Errorf("token %s: got %v want ErrResume", token, err)
+		}
+	}
+}
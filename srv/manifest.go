@@ -0,0 +1,151 @@
+package srv
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"github.com/ability-sh/abi-micro/oss"
+	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/protobuf/proto"
+)
+
+/**
+* 清单摘要，不包含每次签名都会变化的下载URL
+**/
+func manifestHash(m *pb.Manifest) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func (s *server) ContainerManifest(c context.Context, task *pb.ContainerManifestTask) (*pb.ContainerManifestResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Cid == "" {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"}, nil
+	}
+
+	if task.Ability == "" {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ability"}, nil
+	}
+
+	if task.Expires <= 0 {
+		task.Expires = 300
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := app.Store.Container().Get(c, task.Cid)
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "container")
+		return &pb.ContainerManifestResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	container := &pb.Container{}
+
+	setContainer(container, rs)
+
+	acs, err := app.Store.Collection("ac").Find(c, bson.D{bson.E{"cid", task.Cid}}, bson.D{bson.E{"appid", 1}}, 0)
+
+	if err != nil {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	vers := map[string]*pb.Ver{}
+	docs := map[string]bson.M{}
+
+	{
+		or := bson.A{}
+
+		for _, item := range toAcItems(acs) {
+			if item.Ver != "" {
+				or = append(or, bson.D{bson.E{"appid", item.Appid}, bson.E{"ver", item.Ver}})
+			}
+		}
+
+		if len(or) > 0 {
+
+			items, err := app.Store.Collection("ver").Find(c, bson.D{bson.E{"$or", or}}, nil, 0)
+
+			if err != nil {
+				return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			}
+
+			for _, item := range items {
+				v := &pb.Ver{}
+				setVer(v, item)
+				vers[v.Appid+"/"+v.Ver] = v
+				docs[v.Appid+"/"+v.Ver] = item
+			}
+		}
+	}
+
+	m := &pb.Manifest{Container: container, Apps: []*pb.ManifestApp{}}
+
+	for _, ac := range toAcItems(acs) {
+
+		env := map[string]string{}
+
+		for key, value := range container.Env {
+			env[key] = value
+		}
+
+		for key, value := range ac.Env {
+			env[key] = value
+		}
+
+		m.Apps = append(m.Apps, &pb.ManifestApp{Ac: ac, Ver: vers[ac.Appid+"/"+ac.Ver], Env: env})
+	}
+
+	m.Hash, err = manifestHash(m)
+
+	if err != nil {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	ss, err := oss.GetOSS(ctx, SERVICE_OSS)
+
+	if err != nil {
+		return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	for _, item := range m.Apps {
+
+		if item.Ver == nil {
+			continue
+		}
+
+		ok, err := hasPackage(ss, app, docs[item.Ver.Appid+"/"+item.Ver.Ver], task.Ability)
+
+		if err != nil {
+			return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+
+		if !ok {
+			continue
+		}
+
+		item.Url, err = ss.GetSignURL(app.PackageKey(item.Ver.Appid, item.Ver.Ver, task.Ability), time.Duration(task.Expires)*time.Second)
+
+		if err != nil {
+			return &pb.ContainerManifestResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+	}
+
+	return &pb.ContainerManifestResult{Errno: ERRNO_OK, Data: m}, nil
+}
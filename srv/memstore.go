@@ -0,0 +1,69 @@
+package srv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+/**
+* 内存键值存储，用于单元测试及无 mongodb 的开发模式
+**/
+type memoryKV struct {
+	lock  sync.RWMutex
+	items map[string][]byte
+}
+
+func newMemoryStore() Store {
+	return newKVStore(&memoryKV{items: map[string][]byte{}})
+}
+
+func (s *memoryKV) Get(key []byte) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.items[string(key)], nil
+}
+
+func (s *memoryKV) Write(batch []kvWrite) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, w := range batch {
+		if w.Value == nil {
+			delete(s.items, string(w.Key))
+		} else {
+			s.items[string(w.Key)] = w.Value
+		}
+	}
+	return nil
+}
+
+func (s *memoryKV) Each(prefix []byte, fn func(key []byte, value []byte) bool) error {
+
+	s.lock.RLock()
+
+	keys := []string{}
+
+	for key := range s.items {
+		if bytes.HasPrefix([]byte(key), prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	values := map[string][]byte{}
+
+	for _, key := range keys {
+		values[key] = s.items[key]
+	}
+
+	s.lock.RUnlock()
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !fn([]byte(key), values[key]) {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package srv
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+/**
+* tx 为 false 时(单节点 mongod 不支持事务)事务内的写入逐条执行，失败时不回滚已完成的写入
+* preImages 为 false 时(mongodb 6.0 以下或单节点)删除事件无法携带文档，不支持监听
+**/
+type mongoBackend struct {
+	db        *mongo.Database
+	tx        bool
+	preImages bool
+}
+
+var ErrWatchPreImages = errors.New("watch requires mongodb 6.0 replica set with change stream pre-images")
+
+/**
+* 监听的集合，需开启变更前镜像，删除事件按变更前的文档过滤及恢复
+**/
+var watchCollections = []string{"container", "ac"}
+
+type mongoCollection struct {
+	coll *mongo.Collection
+}
+
+/**
+* mongodb 存储，副本集及分片集群使用事务，单节点 mongod 降级为无事务执行
+**/
+func newMongoStore(c context.Context, db *mongo.Database) (Store, error) {
+
+	tx, err := mongoTransactionSupported(c, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	preImages := tx
+
+	if tx {
+		for _, name := range watchCollections {
+			err = enablePreImages(c, db, name)
+			if err != nil {
+				preImages = false
+				break
+			}
+		}
+	}
+
+	return newStore(&mongoBackend{db: db, tx: tx, preImages: preImages}), nil
+}
+
+/**
+* 开启集合的变更前镜像，集合不存在时创建
+**/
+func enablePreImages(c context.Context, db *mongo.Database, name string) error {
+
+	opt := bson.D{bson.E{"enabled", true}}
+
+	err := db.RunCommand(c, bson.D{bson.E{"collMod", name}, bson.E{"changeStreamPreAndPostImages", opt}}).Err()
+
+	var e mongo.CommandError
+
+	if errors.As(err, &e) && e.Code == 26 {
+		err = db.RunCommand(c, bson.D{bson.E{"create", name}, bson.E{"changeStreamPreAndPostImages", opt}}).Err()
+	}
+
+	return err
+}
+
+/**
+* 副本集(setName)或 mongos(msg 为 isdbgrid)支持事务
+**/
+func mongoTransactionSupported(c context.Context, db *mongo.Database) (bool, error) {
+
+	var rs bson.M
+
+	err := db.RunCommand(c, bson.D{bson.E{"hello", 1}}).Decode(&rs)
+
+	if err != nil {
+		err = db.RunCommand(c, bson.D{bson.E{"isMaster", 1}}).Decode(&rs)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if _, ok := rs["setName"]; ok {
+		return true, nil
+	}
+
+	return rs["msg"] == "isdbgrid", nil
+}
+
+func (s *mongoBackend) collection(name string, keys []string) Collection {
+	return &mongoCollection{coll: s.db.Collection(name)}
+}
+
+func (s *mongoBackend) transaction(c context.Context, fn func(c context.Context) error) error {
+
+	if !s.tx || mongo.SessionFromContext(c) != nil {
+		return fn(c)
+	}
+
+	return s.db.Client().UseSession(c, func(sc mongo.SessionContext) error {
+		_, err := sc.WithTransaction(sc, func(sc mongo.SessionContext) (interface{}, error) {
+			return nil, fn(sc)
+		})
+		return err
+	})
+}
+
+type changeEvent struct {
+	OperationType string `bson:"operationType"`
+	Ns            struct {
+		Coll string `bson:"coll"`
+	} `bson:"ns"`
+	DocumentKey              bson.M `bson:"documentKey"`
+	FullDocument             bson.M `bson:"fullDocument"`
+	FullDocumentBeforeChange bson.M `bson:"fullDocumentBeforeChange"`
+}
+
+var watchOperationTypes = bson.A{"insert", "update", "replace", "delete"}
+
+func encodeResumeToken(token bson.Raw) string {
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+func decodeResumeToken(s string) (bson.Raw, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrResume
+	}
+	token := bson.Raw(b)
+	err = token.Validate()
+	if err != nil {
+		return nil, ErrResume
+	}
+	return token, nil
+}
+
+/**
+* 变更流条件，_id 按 documentKey 匹配，其余字段按 fullDocument 匹配
+* 删除事件按变更前镜像 fullDocumentBeforeChange 匹配
+**/
+func watchPipeline(filters map[string]bson.D) mongo.Pipeline {
+
+	or := bson.A{}
+
+	for name, filter := range filters {
+
+		match := bson.D{}
+		deleted := bson.D{bson.E{"operationType", "delete"}}
+
+		for _, e := range filter {
+			if e.Key == "_id" {
+				match = append(match, bson.E{"documentKey._id", e.Value})
+				deleted = append(deleted, bson.E{"documentKey._id", e.Value})
+			} else {
+				match = append(match, bson.E{"fullDocument." + e.Key, e.Value})
+				deleted = append(deleted, bson.E{"fullDocumentBeforeChange." + e.Key, e.Value})
+			}
+		}
+
+		or = append(or, bson.D{bson.E{"ns.coll", name}, bson.E{"$or", bson.A{match, deleted}}})
+	}
+
+	return mongo.Pipeline{bson.D{bson.E{"$match", bson.D{
+		bson.E{"operationType", bson.D{bson.E{"$in", watchOperationTypes}}},
+		bson.E{"$or", or}}}}}
+}
+
+/**
+* 基于变更流监听，须部署为 mongodb 6.0 以上副本集，断线期间的删除在恢复后携带变更前的文档
+**/
+func (s *mongoBackend) watch(c context.Context, filters map[string]bson.D, token string, fn func(e *ChangeEvent) error) error {
+
+	if !s.preImages {
+		return ErrWatchPreImages
+	}
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup).SetFullDocumentBeforeChange(options.WhenAvailable)
+
+	if token != "" {
+		t, err := decodeResumeToken(token)
+		if err != nil {
+			return err
+		}
+		opts = opts.SetResumeAfter(t)
+	}
+
+	cs, err := s.db.Watch(c, watchPipeline(filters), opts)
+
+	if err != nil {
+		return err
+	}
+
+	defer cs.Close(context.Background())
+
+	for cs.Next(c) {
+
+		var e changeEvent
+
+		err = cs.Decode(&e)
+
+		if err != nil {
+			return err
+		}
+
+		err = fn(&ChangeEvent{Type: e.OperationType,
+			Coll:   e.Ns.Coll,
+			Id:     e.DocumentKey["_id"],
+			Doc:    e.FullDocument,
+			Before: e.FullDocumentBeforeChange,
+			Token:  encodeResumeToken(cs.ResumeToken())})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Err() != nil {
+		return nil
+	}
+
+	return cs.Err()
+}
+
+func (s *mongoCollection) Get(c context.Context, filter bson.D) (bson.M, error) {
+
+	var rs bson.M
+
+	err := s.coll.FindOne(c, filter).Decode(&rs)
+
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *mongoCollection) Find(c context.Context, filter bson.D, sort bson.D, limit int64) ([]bson.M, error) {
+
+	opts := options.Find()
+
+	if len(sort) > 0 {
+		opts = opts.SetSort(sort)
+	}
+
+	if limit > 0 {
+		opts = opts.SetLimit(limit)
+	}
+
+	cursor, err := s.coll.Find(c, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(c)
+
+	items := []bson.M{}
+
+	err = cursor.All(c, &items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (s *mongoCollection) Count(c context.Context, filter bson.D) (int64, error) {
+	return s.coll.CountDocuments(c, filter)
+}
+
+func (s *mongoCollection) Query(c context.Context, q *Query) (*QueryResult, error) {
+
+	n := q.N
+
+	if n < 1 {
+		n = 20
+	}
+
+	sort := q.sort()
+
+	filter, err := q.filter()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &QueryResult{}
+
+	opts := options.Find().SetSort(sort).SetLimit(int64(n))
+
+	if q.P > 0 || q.Count {
+
+		totalCount, err := s.coll.CountDocuments(c, filter)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rs.Page = newPage(q.P, n, totalCount)
+	}
+
+	if q.P > 0 {
+		opts = opts.SetSkip(int64(n * (q.P - 1)))
+	}
+
+	if q.Cursor != "" {
+
+		filter, err = cursorFilter(filter, sort, q.Cursor)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cursor, err := s.coll.Find(c, filter, opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer cursor.Close(c)
+
+	err = cursor.All(c, &rs.Items)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rs.Cursor, err = nextCursor(sort, rs.Items, n)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *mongoCollection) Create(c context.Context, doc bson.D) error {
+
+	_, err := s.coll.InsertOne(c, doc)
+
+	if err != nil && mongo.IsDuplicateKeyError(err) {
+		return ErrDuplicate
+	}
+
+	return err
+}
+
+/**
+* 转换为 mongo 更新文档
+**/
+func (u *Update) doc() bson.D {
+
+	doc := bson.D{}
+
+	if len(u.Set) > 0 {
+		doc = append(doc, bson.E{"$set", u.Set})
+	}
+
+	inc := append(bson.D{}, u.Inc...)
+
+	if !u.KeepRev {
+		inc = append(inc, bson.E{"rev", 1})
+	}
+
+	if len(inc) > 0 {
+		doc = append(doc, bson.E{"$inc", inc})
+	}
+
+	if len(u.Push) > 0 {
+		doc = append(doc, bson.E{"$push", u.Push})
+	}
+
+	if len(u.SetOnInsert) > 0 {
+		doc = append(doc, bson.E{"$setOnInsert", u.SetOnInsert})
+	}
+
+	return doc
+}
+
+func (s *mongoCollection) Update(c context.Context, filter bson.D, u *Update) (bson.M, error) {
+
+	opts := options.FindOneAndUpdate().SetUpsert(u.Upsert && u.Rev <= 0).SetReturnDocument(options.After)
+
+	var rs bson.M
+
+	err := s.coll.FindOneAndUpdate(c, withRev(filter, u.Rev), u.doc(), opts).Decode(&rs)
+
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, s.notFoundOrConflict(c, filter, u.Rev)
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrDuplicate
+		}
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *mongoCollection) UpdateMany(c context.Context, filter bson.D, u *Update) (int64, error) {
+
+	r, err := s.coll.UpdateMany(c, filter, u.doc())
+
+	if err != nil {
+		return 0, err
+	}
+
+	return r.MatchedCount, nil
+}
+
+func (s *mongoCollection) Remove(c context.Context, filter bson.D, rev int32) (bson.M, error) {
+
+	var rs bson.M
+
+	err := s.coll.FindOneAndDelete(c, withRev(filter, rev)).Decode(&rs)
+
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, s.notFoundOrConflict(c, filter, rev)
+		}
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+func (s *mongoCollection) RemoveMany(c context.Context, filter bson.D) ([]bson.M, error) {
+
+	items, err := s.Find(c, filter, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	ids := bson.A{}
+
+	for _, item := range items {
+		ids = append(ids, item["_id"])
+	}
+
+	_, err = s.coll.DeleteMany(c, bson.D{bson.E{"_id", bson.D{bson.E{"$in", ids}}}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+/**
+* 未匹配到文档时，区分文档不存在与版本冲突
+**/
+func (s *mongoCollection) notFoundOrConflict(c context.Context, filter bson.D, rev int32) error {
+
+	if rev > 0 {
+
+		n, err := s.coll.CountDocuments(c, filter, options.Count().SetLimit(1))
+
+		if err != nil {
+			return err
+		}
+
+		if n > 0 {
+			return ErrConflict
+		}
+	}
+
+	return ErrNotFound
+}
@@ -0,0 +1,40 @@
+package srv
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 删除事件按变更前镜像过滤，断线恢复后仍可匹配 cid/appid
+**/
+func TestWatchPipeline(t *testing.T) {
+
+	pipeline := watchPipeline(map[string]bson.D{"ac": {bson.E{"cid", "c1"}}})
+
+	events := []bson.M{
+		{"operationType": "insert", "ns": bson.M{"coll": "ac"}, "fullDocument": bson.M{"cid": "c1"}},
+		{"operationType": "delete", "ns": bson.M{"coll": "ac"}, "fullDocumentBeforeChange": bson.M{"cid": "c1"}},
+		{"operationType": "delete", "ns": bson.M{"coll": "ac"}, "fullDocumentBeforeChange": bson.M{"cid": "c2"}},
+		{"operationType": "delete", "ns": bson.M{"coll": "ac"}},
+		{"operationType": "insert", "ns": bson.M{"coll": "app"}, "fullDocument": bson.M{"cid": "c1"}},
+	}
+
+	want := []bool{true, true, false, false, false}
+
+	match := pipeline[0][0].Value.(bson.D)
+
+	for i, e := range events {
+
+		ok, err := matchDoc(e, match)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ok != want[i] {
+			t.Errorf("event %d %v: got %v want %v", i, e, ok, want[i])
+		}
+	}
+}
@@ -0,0 +1,529 @@
+package srv
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-lib/json"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"github.com/ability-sh/abi-micro/micro"
+	"github.com/ability-sh/abi-micro/oss"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	MANIFEST_MAX_SIZE   = 1024 * 1024
+	PACKAGE_URL_EXPIRES = 300
+)
+
+/**
+* ability 作为 packages 的键，不能包含 . $ /
+**/
+func validAbility(ability string) bool {
+	return ability != "" && !strings.ContainsAny(ability, ".$/")
+}
+
+func setPackages(a *pb.Ver, rs bson.M) {
+
+	keys := []string{}
+	items := map[string]interface{}{}
+
+	dynamic.Each(rs["packages"], func(key interface{}, value interface{}) bool {
+		k := dynamic.StringValue(key, "")
+		keys = append(keys, k)
+		items[k] = value
+		return true
+	})
+
+	sort.Strings(keys)
+
+	a.Packages = []*pb.Package{}
+
+	for _, key := range keys {
+		v := items[key]
+		a.Packages = append(a.Packages, &pb.Package{
+			Ability:  key,
+			Size:     dynamic.IntValue(dynamic.Get(v, "size"), 0),
+			Sha256:   dynamic.StringValue(dynamic.Get(v, "sha256"), ""),
+			Etag:     dynamic.StringValue(dynamic.Get(v, "etag"), ""),
+			Mtime:    int32(dynamic.IntValue(dynamic.Get(v, "mtime"), 0)),
+			Verified: dynamic.BooleanValue(dynamic.Get(v, "verified"), false),
+		})
+	}
+}
+
+/**
+* 版本是否有 ability 对应的应用包
+* 引入应用包登记前上传的版本(packages_legacy 或无 packages)未登记的 ability 按 OSS 对象是否存在判断
+**/
+func hasPackage(ss oss.OSS, app *AppService, v bson.M, ability string) (bool, error) {
+
+	if v == nil {
+		return false, nil
+	}
+
+	if dynamic.Get(v["packages"], ability) != nil {
+		return true, nil
+	}
+
+	if v["packages"] != nil && !dynamic.BooleanValue(v["packages_legacy"], false) {
+		return false, nil
+	}
+
+	return ss.Has(app.PackageKey(dynamic.StringValue(v["appid"], ""), dynamic.StringValue(v["ver"], ""), ability))
+}
+
+/**
+* 标记引入应用包登记前的版本(无 packages)，登记部分应用包后其余 ability 仍按 OSS 对象判断
+**/
+func migrateVerPackages(c context.Context, app *AppService) error {
+
+	db_ver := app.Store.Collection("ver")
+
+	items, err := db_ver.Find(c, bson.D{bson.E{"packages", bson.D{bson.E{"$exists", false}}}}, nil, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+
+		_, err = db_ver.Update(c, bson.D{bson.E{"appid", item["appid"]}, bson.E{"ver", item["ver"]}, bson.E{"packages", bson.D{bson.E{"$exists", false}}}},
+			&Update{Set: bson.D{bson.E{"packages", bson.D{}}, bson.E{"packages_legacy", true}}, KeepRev: true})
+
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/**
+* 校验应用包已登记
+**/
+func checkPackage(c context.Context, ctx micro.Context, app *AppService, appid string, ver string, ability string) (int32, error) {
+
+	if !validAbility(ability) {
+		return ERRNO_INPUT_DATA, fmt.Errorf("invalid ability %s", ability)
+	}
+
+	rs, err := app.Store.Ver().Get(c, appid, ver)
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ver")
+		return errno, errors.New(errmsg)
+	}
+
+	ss, err := oss.GetOSS(ctx, SERVICE_OSS)
+
+	if err != nil {
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	ok, err := hasPackage(ss, app, rs, ability)
+
+	if err != nil {
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	if !ok {
+		return ERRNO_NOT_FOUND, fmt.Errorf("not found package %s/%s/%s", appid, ver, ability)
+	}
+
+	return ERRNO_OK, nil
+}
+
+/**
+* 版本已登记的应用包及额外指定的 ability 对应的 OSS 路径
+**/
+func packageKeys(app *AppService, appid string, v bson.M, abilities []string) []string {
+
+	ver := dynamic.StringValue(v["ver"], "")
+
+	set := map[string]bool{}
+	keys := []string{}
+
+	add := func(ability string) {
+		if !set[ability] {
+			set[ability] = true
+			keys = append(keys, app.PackageKey(appid, ver, ability))
+		}
+	}
+
+	dynamic.Each(v["packages"], func(key interface{}, value interface{}) bool {
+		add(dynamic.StringValue(key, ""))
+		return true
+	})
+
+	for _, ability := range abilities {
+		add(ability)
+	}
+
+	return keys
+}
+
+/**
+* 打开 OSS 中的应用包，OSS 支持 Open 时直接读取，否则通过签名 URL 下载
+**/
+func openPackage(c context.Context, ctx micro.Context, key string) (io.ReadCloser, error) {
+
+	s, err := ctx.GetService(SERVICE_OSS)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ss, ok := s.(oss.OSSService)
+
+	if !ok {
+		return nil, fmt.Errorf("service %s not instanceof OSSService", SERVICE_OSS)
+	}
+
+	if o, ok := ss.OSS().(interface {
+		Open(key string) (io.ReadCloser, error)
+	}); ok {
+		return o.Open(key)
+	}
+
+	u, err := ss.OSS().GetSignURL(key, PACKAGE_URL_EXPIRES*time.Second)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(c, http.MethodGet, u, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("get package status %d", resp.StatusCode)
+	}
+
+	return resp.Body, nil
+}
+
+/**
+* 从 OSS 读取应用包，返回内容及 sha256，最多读取 AppMaxSize+1 字节，超出时拒绝
+**/
+func fetchPackage(c context.Context, ctx micro.Context, app *AppService, appid string, ver string, ability string) ([]byte, string, int32, error) {
+
+	ss, err := oss.GetOSS(ctx, SERVICE_OSS)
+
+	if err != nil {
+		return nil, "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	key := app.PackageKey(appid, ver, ability)
+
+	has, err := ss.Has(key)
+
+	if err != nil {
+		return nil, "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	if !has {
+		return nil, "", ERRNO_NOT_FOUND, fmt.Errorf("not found package")
+	}
+
+	rd, err := openPackage(c, ctx, key)
+
+	if err != nil {
+		return nil, "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	defer rd.Close()
+
+	var r io.Reader = rd
+
+	if app.AppMaxSize > 0 {
+		r = io.LimitReader(rd, app.AppMaxSize+1)
+	}
+
+	data, err := io.ReadAll(r)
+
+	if err != nil {
+		return nil, "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	if app.AppMaxSize > 0 && int64(len(data)) > app.AppMaxSize {
+		return nil, "", ERRNO_INPUT_DATA, fmt.Errorf("package too large")
+	}
+
+	h := sha256.Sum256(data)
+
+	return data, hex.EncodeToString(h[:]), ERRNO_OK, nil
+}
+
+/**
+* 读取应用包中的清单文件，允许位于包内顶层目录中
+**/
+func readManifest(data []byte, name string) (interface{}, error) {
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var f *zip.File = nil
+
+	for _, item := range r.File {
+		if item.Name == name {
+			f = item
+			break
+		}
+		if f == nil && strings.Count(item.Name, "/") == 1 && strings.HasSuffix(item.Name, "/"+name) {
+			f = item
+		}
+	}
+
+	if f == nil {
+		return nil, fmt.Errorf("not found %s in package", name)
+	}
+
+	if f.UncompressedSize64 > MANIFEST_MAX_SIZE {
+		return nil, fmt.Errorf("%s too large", name)
+	}
+
+	rd, err := f.Open()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rd.Close()
+
+	b, err := io.ReadAll(io.LimitReader(rd, MANIFEST_MAX_SIZE+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var info interface{} = nil
+
+	err = json.Unmarshal(b, &info)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := info.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("%s not object", name)
+	}
+
+	return info, nil
+}
+
+func (s *server) VerUpConfirm(c context.Context, task *pb.VerUpConfirmTask) (*pb.VerResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Ver == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ver"}, nil
+	}
+
+	if task.Ability == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ability"}, nil
+	}
+
+	if !validAbility(task.Ability) {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "invalid param ability"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	before := findDoc(c, app.Store.Collection("ver"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}})
+
+	if before == nil {
+		return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ver"}, nil
+	}
+
+	data, sum, errno, err := fetchPackage(c, ctx, app, task.Appid, task.Ver, task.Ability)
+
+	if err != nil {
+		return &pb.VerResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	if task.Sha256 != "" && !strings.EqualFold(task.Sha256, sum) {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "package sha256 mismatch"}, nil
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		before = findDoc(c, app.Store.Collection("ver"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}})
+
+		rs, err = app.Store.Ver().Update(c, task.Appid, task.Ver, &Update{
+			Set: bson.D{bson.E{fmt.Sprintf("packages.%s", task.Ability), bson.D{
+				bson.E{"size", int64(len(data))},
+				bson.E{"sha256", sum},
+				bson.E{"etag", task.Etag},
+				bson.E{"mtime", int32(time.Now().Unix())}}}}})
+
+		if err != nil {
+			return err
+		}
+
+		if pb.VerStatus(dynamic.IntValue(rs["status"], 0)) == pb.VerStatus_VER_DRAFT {
+
+			after, err := app.Store.Collection("ver").Update(c,
+				bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}, bson.E{"status", int32(pb.VerStatus_VER_DRAFT)}},
+				&Update{Set: bson.D{bson.E{"status", int32(pb.VerStatus_VER_UPLOADED)}},
+					Push: bson.D{bson.E{"transitions", verTransitionDoc(c, pb.VerStatus_VER_DRAFT, pb.VerStatus_VER_UPLOADED)}}})
+
+			if err == nil {
+				rs = after
+			} else if err != ErrNotFound {
+				return err
+			}
+		}
+
+		return audit(c, ctx, app, "VerUpConfirm", AUDIT_VER, task.Appid+"/"+task.Ver, before, rs)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ver")
+		return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Ver{}
+
+	setVer(a, rs)
+
+	return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) VerVerify(c context.Context, task *pb.VerVerifyTask) (*pb.VerResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Ver == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ver"}, nil
+	}
+
+	if task.Ability == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ability"}, nil
+	}
+
+	if !validAbility(task.Ability) {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "invalid param ability"}, nil
+	}
+
+	if task.Manifest == "" {
+		task.Manifest = "app.json"
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	before := findDoc(c, app.Store.Collection("ver"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}})
+
+	if before == nil {
+		return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ver"}, nil
+	}
+
+	key := fmt.Sprintf("packages.%s", task.Ability)
+
+	recorded := dynamic.StringValue(dynamic.Get(dynamic.Get(before["packages"], task.Ability), "sha256"), "")
+
+	if recorded == "" {
+		return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found package, VerUpConfirm first"}, nil
+	}
+
+	data, sum, errno, err := fetchPackage(c, ctx, app, task.Appid, task.Ver, task.Ability)
+
+	if err != nil {
+		return &pb.VerResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	if !strings.EqualFold(recorded, sum) {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "package sha256 mismatch with VerUpConfirm"}, nil
+	}
+
+	info, err := readManifest(data, task.Manifest)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		// 仅在登记的 sha256 未变化时写入校验结果
+		before = findDoc(c, app.Store.Collection("ver"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}, bson.E{key + ".sha256", recorded}})
+
+		if before == nil {
+			return ErrConflict
+		}
+
+		rs, err = app.Store.Ver().Update(c, task.Appid, task.Ver, &Update{
+			Set: bson.D{
+				bson.E{"info", info},
+				bson.E{key + ".verified", true},
+				bson.E{key + ".mtime", int32(time.Now().Unix())}}})
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "VerVerify", AUDIT_VER, task.Appid+"/"+task.Ver, before, rs)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ver")
+		return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Ver{}
+
+	setVer(a, rs)
+
+	return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
+}
@@ -0,0 +1,333 @@
+package srv
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"github.com/ability-sh/abi-micro/oss"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func testPackage(t *testing.T, manifest string) []byte {
+
+	b := bytes.NewBuffer(nil)
+
+	w := zip.NewWriter(b)
+
+	f, err := w.Create("app.json")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.Write([]byte(manifest))
+
+	err = w.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b.Bytes()
+}
+
+func TestFSOSS(t *testing.T) {
+
+	s := newFSOSS(t.TempDir(), "http://localhost/oss")
+
+	err := s.Put("a/b.zip", []byte("abc"), nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	has, err := s.Has("a/b.zip")
+
+	if err != nil || !has {
+		t.Fatalf("Has %v %v", has, err)
+	}
+
+	rd, err := s.Open("a/b.zip")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := io.ReadAll(rd)
+
+	rd.Close()
+
+	if err != nil || string(b) != "abc" {
+		t.Fatalf("Open %s %v", b, err)
+	}
+
+	data, err := s.Get("/a/../a/b.zip")
+
+	if err != nil || string(data) != "abc" {
+		t.Fatalf("Get %s %v", data, err)
+	}
+
+	if s.path("../../etc/passwd") != s.path("etc/passwd") {
+		t.Fatalf("path escapes dir %s", s.path("../../etc/passwd"))
+	}
+
+	if s.GetURL("a/b.zip") != "http://localhost/oss/a/b.zip" {
+		t.Fatalf("GetURL %s", s.GetURL("a/b.zip"))
+	}
+
+	err = s.Del("a/b.zip")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	has, _ = s.Has("a/b.zip")
+
+	if has || s.Del("a/b.zip") != nil {
+		t.Fatalf("Del")
+	}
+}
+
+/**
+* 不支持 Open 的 OSS，通过签名 URL 下载
+**/
+type urlOSS struct {
+	oss.OSS
+	baseURL string
+}
+
+func (s *urlOSS) GetSignURL(key string, expires time.Duration) (string, error) {
+	return s.baseURL + "/" + key, nil
+}
+
+type urlOSSService struct {
+	*fsOSSService
+	oss oss.OSS
+}
+
+func (s *urlOSSService) OSS() oss.OSS {
+	return s.oss
+}
+
+/**
+* 通过签名 URL 下载的应用包同样按大小上限读取
+**/
+func TestFetchPackageLimit(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	dir := t.TempDir()
+	fs := newFSOSS(dir, "")
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+
+	big := app.PackageKey(appid, "1.0.0", "big")
+
+	var written int64
+
+	files := http.FileServer(http.Dir(dir))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+big {
+			b := make([]byte, 64*1024)
+			for i := 0; i < 1024; i++ {
+				n, err := w.Write(b)
+				atomic.AddInt64(&written, int64(n))
+				if err != nil {
+					return
+				}
+			}
+			return
+		}
+		files.ServeHTTP(w, r)
+	}))
+
+	grpc.GetContext(c).(*testContext).services[SERVICE_OSS] = &urlOSSService{fsOSSService: newFSOSSService(SERVICE_OSS, nil), oss: &urlOSS{OSS: fs, baseURL: srv.URL}}
+
+	data := testPackage(t, `{"name":"demo"}`)
+
+	fs.Put(app.PackageKey(appid, "1.0.0", "web"), data, nil)
+	fs.Put(big, []byte("x"), nil)
+
+	app.AppMaxSize = int64(len(data))
+
+	rs, _ := s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Packages[0].Size != int64(len(data)) {
+		t.Fatalf("VerUpConfirm by url %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.AppMaxSize = 1024
+
+	rs, _ = s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "1.0.0", Ability: "big"})
+
+	if rs.Errno != ERRNO_INPUT_DATA || rs.Errmsg != "package too large" {
+		t.Fatalf("VerUpConfirm too large by url %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	srv.Close()
+
+	if n := atomic.LoadInt64(&written); n >= 64*1024*1024 {
+		t.Fatalf("package read past limit %d", n)
+	}
+}
+
+func TestVerVerify(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	ss := newFSOSSService(SERVICE_OSS, nil)
+	ss.oss = newFSOSS(t.TempDir(), "")
+
+	grpc.GetContext(c).(*testContext).services[SERVICE_OSS] = ss
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+
+	key := app.PackageKey(appid, "1.0.0", "web")
+
+	rs, _ := s.VerVerify(c, &pb.VerVerifyTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("VerVerify before confirm %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	ss.oss.Put(key, testPackage(t, `{"name":"demo"}`), nil)
+
+	rs, _ = s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_OK || len(rs.Data.Packages) != 1 || rs.Data.Status != pb.VerStatus_VER_UPLOADED {
+		t.Fatalf("VerUpConfirm %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.VerVerify(c, &pb.VerVerifyTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_OK || !rs.Data.Packages[0].Verified {
+		t.Fatalf("VerVerify %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	/* 确认后被替换的包与登记的 sha256 不一致 */
+
+	ss.oss.Put(key, testPackage(t, `{"name":"other"}`), nil)
+
+	rs, _ = s.VerVerify(c, &pb.VerVerifyTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_INPUT_DATA {
+		t.Fatalf("VerVerify mismatch %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	app.AppMaxSize = 10
+
+	rs, _ = s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_INPUT_DATA || rs.Errmsg != "package too large" {
+		t.Fatalf("VerUpConfirm too large %d %s", rs.Errno, rs.Errmsg)
+	}
+}
+
+/**
+* 引入应用包登记前上传的版本按 OSS 对象判断，新版本须先登记
+**/
+func TestLegacyPackages(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	ss := newFSOSSService(SERVICE_OSS, nil)
+	ss.oss = newFSOSS(t.TempDir(), "")
+
+	ctx := grpc.GetContext(c)
+
+	ctx.(*testContext).services[SERVICE_OSS] = ss
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	err := app.Store.Collection("ver").Create(c, bson.D{bson.E{"appid", appid}, bson.E{"ver", "0.9.0"}, bson.E{"status", int32(0)}, bson.E{"rev", int32(1)}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+
+	for _, ver := range []string{"0.9.0", "1.0.0"} {
+		for _, ability := range []string{"web", "api"} {
+			ss.oss.Put(app.PackageKey(appid, ver, ability), testPackage(t, `{}`), nil)
+		}
+	}
+
+	cases := []struct {
+		ver     string
+		ability string
+		errno   int32
+	}{
+		{"0.9.0", "web", ERRNO_OK},
+		{"0.9.0", "api", ERRNO_OK},
+		{"0.9.0", "ios", ERRNO_NOT_FOUND},
+		{"1.0.0", "web", ERRNO_NOT_FOUND},
+		{"2.0.0", "web", ERRNO_NOT_FOUND},
+	}
+
+	check := func(step string) {
+		for _, e := range cases {
+			errno, _ := checkPackage(c, ctx, app, appid, e.ver, e.ability)
+			if errno != e.errno {
+				t.Fatalf("%s %s/%s: got %d want %d", step, e.ver, e.ability, errno, e.errno)
+			}
+		}
+	}
+
+	check("before migrate")
+
+	err = migrateVerPackages(c, app)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check("after migrate")
+
+	/* 登记部分应用包后其余 ability 仍可用 */
+
+	rs, _ := s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "0.9.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_OK || len(rs.Data.Packages) != 1 {
+		t.Fatalf("VerUpConfirm legacy %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	check("after confirm")
+
+	rs, _ = s.VerUpConfirm(c, &pb.VerUpConfirmTask{Appid: appid, Ver: "1.0.0", Ability: "web"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("VerUpConfirm %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	errno, err := checkPackage(c, ctx, app, appid, "1.0.0", "web")
+
+	if errno != ERRNO_OK {
+		t.Fatalf("checkPackage confirmed %d %v", errno, err)
+	}
+}
@@ -0,0 +1,635 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	AUDIT_USER         = "user"
+	AUDIT_ROLE         = "role"
+	AUDIT_ROLE_BINDING = "role_binding"
+)
+
+var viewerMethods = []string{
+	"AppGet", "AppQuery",
+	"VerGet", "VerQuery", "VerResolve",
+	"ContainerGet", "ContainerQuery", "ContainerManifest", "ContainerWatch",
+	"AcGet", "AcQuery", "AcWatch", "AcHistory",
+	"ChannelGet", "ChannelQuery", "ChannelHistory",
+	"RolloutGet", "RolloutQuery", "RolloutItemQuery",
+}
+
+/**
+* 内置角色，首次启动时写入 role 集合，之后可通过 RoleSet 修改
+* developer 可在绑定的应用下发布版本，operator 可在绑定的容器下修改应用
+**/
+var builtinRoles = []*pb.Role{
+	{Name: "viewer", Title: "只读", Methods: viewerMethods},
+	{Name: "developer", Title: "开发者", Methods: append([]string{"VerCreate", "VerSet", "VerUpURL", "VerUpConfirm", "VerVerify", "VerGetURL", "ChannelSet", "ChannelRemove"}, viewerMethods...)},
+	{Name: "operator", Title: "运维", Methods: append([]string{"ContainerSet", "AcAdd", "AcSet", "AcRemove", "AcRollback", "RolloutCreate", "RolloutStep", "RolloutPause", "RolloutResume", "RolloutAbort"}, viewerMethods...)},
+}
+
+func initRoles(c context.Context, app *AppService) error {
+
+	db_role := app.Store.Collection("role")
+
+	ctime := int32(time.Now().Unix())
+
+	for _, r := range builtinRoles {
+
+		_, err := db_role.Update(c, bson.D{bson.E{"_id", r.Name}},
+			&Update{SetOnInsert: bson.D{bson.E{"title", r.Title}, bson.E{"methods", r.Methods}, bson.E{"ctime", ctime}}, Upsert: true, KeepRev: true})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/**
+* 校验用户是否拥有调用方法的角色，绑定的 appid/cid 为空时对全部资源生效
+**/
+func checkRole(c context.Context, app *AppService, uid string, method string, req interface{}) (bool, error) {
+
+	items, err := app.Store.Collection("role").Find(c, bson.D{bson.E{"methods", method}}, nil, 0)
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(items) == 0 {
+		return false, nil
+	}
+
+	roles := bson.A{}
+
+	for _, item := range items {
+		roles = append(roles, item["_id"])
+	}
+
+	appid := ""
+	cid := ""
+
+	if r, ok := req.(interface{ GetAppid() string }); ok {
+		appid = r.GetAppid()
+	}
+
+	if r, ok := req.(interface{ GetCid() string }); ok {
+		cid = r.GetCid()
+	}
+
+	return exists(c, app.Store.Collection("role_binding"),
+		bson.D{bson.E{"uid", uid},
+			bson.E{"role", bson.D{bson.E{"$in", roles}}},
+			bson.E{"appid", bson.D{bson.E{"$in", bson.A{"", appid}}}},
+			bson.E{"cid", bson.D{bson.E{"$in", bson.A{"", cid}}}}})
+}
+
+/**
+* 服务中定义的全部方法
+**/
+func serviceMethods() map[string]bool {
+	vs := map[string]bool{}
+	for _, m := range pb.Service_ServiceDesc.Methods {
+		vs[m.MethodName] = true
+	}
+	for _, m := range pb.Service_ServiceDesc.Streams {
+		vs[m.StreamName] = true
+	}
+	return vs
+}
+
+func setUser(a *pb.User, rs bson.M) {
+	a.Id = dynamic.StringValue(rs["_id"], "")
+	a.Title = dynamic.StringValue(rs["title"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+}
+
+func toUserItems(rs []bson.M) []*pb.User {
+	vs := []*pb.User{}
+	for _, r := range rs {
+		v := &pb.User{}
+		setUser(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func setRole(a *pb.Role, rs bson.M) {
+	a.Name = dynamic.StringValue(rs["_id"], "")
+	a.Title = dynamic.StringValue(rs["title"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+	a.Methods = []string{}
+	dynamic.Each(rs["methods"], func(key interface{}, value interface{}) bool {
+		a.Methods = append(a.Methods, dynamic.StringValue(value, ""))
+		return true
+	})
+}
+
+func toRoleItems(rs []bson.M) []*pb.Role {
+	vs := []*pb.Role{}
+	for _, r := range rs {
+		v := &pb.Role{}
+		setRole(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func setRoleBinding(a *pb.RoleBinding, rs bson.M) {
+	a.Id = dynamic.StringValue(rs["_id"], "")
+	a.Uid = dynamic.StringValue(rs["uid"], "")
+	a.Role = dynamic.StringValue(rs["role"], "")
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.Cid = dynamic.StringValue(rs["cid"], "")
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+}
+
+func toRoleBindingItems(rs []bson.M) []*pb.RoleBinding {
+	vs := []*pb.RoleBinding{}
+	for _, r := range rs {
+		v := &pb.RoleBinding{}
+		setRoleBinding(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func (s *server) UserCreate(c context.Context, task *pb.UserCreateTask) (*pb.UserResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.UserResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	id := app.NewID()
+	secret := app.NewSecret()
+	ctime := int32(time.Now().Unix())
+
+	enc, err := app.EncryptSecret(secret)
+
+	if err != nil {
+		return &pb.UserResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	doc := bson.D{bson.E{"_id", id},
+		bson.E{"title", task.Title},
+		bson.E{"secret", enc},
+		bson.E{"ctime", ctime}}
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		err := app.Store.Collection("user").Create(c, doc)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "UserCreate", AUDIT_USER, id, nil, doc)
+	})
+
+	if err != nil {
+		return &pb.UserResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.User{Id: id, Title: task.Title, Secret: secret, Ctime: ctime}
+
+	return &pb.UserResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) UserRemove(c context.Context, task *pb.UserRemoveTask) (*pb.UserResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Uid == "" {
+		return &pb.UserResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param uid"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.UserResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	var rs bson.M
+	var bindings []bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = app.Store.Collection("user").Remove(c, bson.D{bson.E{"_id", task.Uid}}, 0)
+
+		if err != nil {
+			return err
+		}
+
+		bindings, err = removeMany(c, app, "role_binding", bson.D{bson.E{"uid", task.Uid}}, false)
+
+		if err != nil {
+			return err
+		}
+
+		err = audit(c, ctx, app, "UserRemove", AUDIT_USER, task.Uid, rs, nil)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range bindings {
+			err = audit(c, ctx, app, "UserRemove", AUDIT_ROLE_BINDING, dynamic.StringValue(v["_id"], ""), v, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "user")
+		return &pb.UserResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.User{}
+
+	setUser(a, rs)
+
+	return &pb.UserResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) UserQuery(c context.Context, task *pb.UserQueryTask) (*pb.UserQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.UserQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	q := &Query{Where: bson.D{},
+		Q:          task.Q,
+		Match:      task.Match,
+		IgnoreCase: task.IgnoreCase,
+		P:          task.P,
+		N:          task.N,
+		Cursor:     task.Cursor,
+		Count:      task.Count}
+
+	err = q.Check()
+
+	if err != nil {
+		return &pb.UserQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := app.Store.Collection("user").Query(c, q)
+
+	if err != nil {
+		return &pb.UserQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.UserQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toUserItems(rs.Items), Cursor: rs.Cursor}, nil
+}
+
+func (s *server) RoleSet(c context.Context, task *pb.RoleSetTask) (*pb.RoleResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Name == "" {
+		return &pb.RoleResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param name"}, nil
+	}
+
+	methods := serviceMethods()
+
+	for _, m := range task.Methods {
+		if !methods[m] {
+			return &pb.RoleResult{Errno: ERRNO_INPUT_DATA, Errmsg: fmt.Sprintf("not found method %s", m)}, nil
+		}
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	db_role := app.Store.Collection("role")
+
+	if task.Methods == nil {
+		task.Methods = []string{}
+	}
+
+	set := bson.D{bson.E{"methods", task.Methods}}
+
+	if task.Title != "" {
+		set = append(set, bson.E{"title", task.Title})
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		before := findDoc(c, db_role, bson.D{bson.E{"_id", task.Name}})
+
+		rs, err = db_role.Update(c, bson.D{bson.E{"_id", task.Name}},
+			&Update{Set: set, SetOnInsert: bson.D{bson.E{"ctime", int32(time.Now().Unix())}}, Upsert: true, KeepRev: true})
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "RoleSet", AUDIT_ROLE, task.Name, before, rs)
+	})
+
+	if err != nil {
+		return &pb.RoleResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.Role{}
+
+	setRole(a, rs)
+
+	return &pb.RoleResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RoleRemove(c context.Context, task *pb.RoleRemoveTask) (*pb.RoleResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Name == "" {
+		return &pb.RoleResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param name"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	ok, err := exists(c, app.Store.Collection("role_binding"), bson.D{bson.E{"role", task.Name}})
+
+	if err != nil {
+		return &pb.RoleResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if ok {
+		return &pb.RoleResult{Errno: ERRNO_CONFLICT, Errmsg: "role in use"}, nil
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = app.Store.Collection("role").Remove(c, bson.D{bson.E{"_id", task.Name}}, 0)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "RoleRemove", AUDIT_ROLE, task.Name, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "role")
+		return &pb.RoleResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Role{}
+
+	setRole(a, rs)
+
+	return &pb.RoleResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RoleQuery(c context.Context, task *pb.RoleQueryTask) (*pb.RoleQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	q := &Query{Where: bson.D{},
+		P:      task.P,
+		N:      task.N,
+		Cursor: task.Cursor,
+		Count:  task.Count}
+
+	err = q.Check()
+
+	if err != nil {
+		return &pb.RoleQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := app.Store.Collection("role").Query(c, q)
+
+	if err != nil {
+		return &pb.RoleQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.RoleQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toRoleItems(rs.Items), Cursor: rs.Cursor}, nil
+}
+
+func (s *server) RoleBindingAdd(c context.Context, task *pb.RoleBindingAddTask) (*pb.RoleBindingResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Uid == "" {
+		return &pb.RoleBindingResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param uid"}, nil
+	}
+
+	if task.Role == "" {
+		return &pb.RoleBindingResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param role"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleBindingResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	ok, err := exists(c, app.Store.Collection("user"), bson.D{bson.E{"_id", task.Uid}})
+
+	if err != nil {
+		return &pb.RoleBindingResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if !ok {
+		return &pb.RoleBindingResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found user"}, nil
+	}
+
+	ok, err = exists(c, app.Store.Collection("role"), bson.D{bson.E{"_id", task.Role}})
+
+	if err != nil {
+		return &pb.RoleBindingResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if !ok {
+		return &pb.RoleBindingResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found role"}, nil
+	}
+
+	if task.Appid != "" {
+
+		errno, err := checkApp(c, app, task.Appid)
+
+		if err != nil {
+			return &pb.RoleBindingResult{Errno: errno, Errmsg: err.Error()}, nil
+		}
+	}
+
+	if task.Cid != "" {
+
+		errno, err := checkContainer(c, app, task.Cid)
+
+		if err != nil {
+			return &pb.RoleBindingResult{Errno: errno, Errmsg: err.Error()}, nil
+		}
+	}
+
+	id := app.NewID()
+	ctime := int32(time.Now().Unix())
+
+	doc := bson.D{bson.E{"_id", id},
+		bson.E{"uid", task.Uid},
+		bson.E{"role", task.Role},
+		bson.E{"appid", task.Appid},
+		bson.E{"cid", task.Cid},
+		bson.E{"ctime", ctime}}
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		err := app.Store.Collection("role_binding").Create(c, doc)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "RoleBindingAdd", AUDIT_ROLE_BINDING, id, nil, doc)
+	})
+
+	if err != nil {
+		if err == ErrDuplicate {
+			return &pb.RoleBindingResult{Errno: ERRNO_DUPLICATE, Errmsg: "role binding exists"}, nil
+		}
+		return &pb.RoleBindingResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.RoleBinding{Id: id, Uid: task.Uid, Role: task.Role, Appid: task.Appid, Cid: task.Cid, Ctime: ctime}
+
+	return &pb.RoleBindingResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RoleBindingRemove(c context.Context, task *pb.RoleBindingRemoveTask) (*pb.RoleBindingResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Id == "" {
+		return &pb.RoleBindingResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleBindingResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = app.Store.Collection("role_binding").Remove(c, bson.D{bson.E{"_id", task.Id}}, 0)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "RoleBindingRemove", AUDIT_ROLE_BINDING, task.Id, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "role binding")
+		return &pb.RoleBindingResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.RoleBinding{}
+
+	setRoleBinding(a, rs)
+
+	return &pb.RoleBindingResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RoleBindingQuery(c context.Context, task *pb.RoleBindingQueryTask) (*pb.RoleBindingQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RoleBindingQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	filter := bson.D{}
+
+	if task.Uid != "" {
+		filter = append(filter, bson.E{"uid", task.Uid})
+	}
+
+	if task.Role != "" {
+		filter = append(filter, bson.E{"role", task.Role})
+	}
+
+	if task.Appid != "" {
+		filter = append(filter, bson.E{"appid", task.Appid})
+	}
+
+	if task.Cid != "" {
+		filter = append(filter, bson.E{"cid", task.Cid})
+	}
+
+	rs, err := app.Store.Collection("role_binding").Query(c, &Query{Where: filter, P: task.P, N: task.N})
+
+	if err != nil {
+		return &pb.RoleBindingQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.RoleBindingQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toRoleBindingItems(rs.Items)}, nil
+}
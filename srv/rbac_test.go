@@ -0,0 +1,246 @@
+package srv
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 用户、角色、审计查询按游标翻页，不重复不遗漏
+**/
+func TestQueryCursor(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	for i := 0; i < 5; i++ {
+
+		u, _ := s.UserCreate(c, &pb.UserCreateTask{Title: fmt.Sprintf("u%d", i)})
+
+		if u.Errno != ERRNO_OK {
+			t.Fatalf("UserCreate %d %s", u.Errno, u.Errmsg)
+		}
+
+		r, _ := s.RoleSet(c, &pb.RoleSetTask{Name: fmt.Sprintf("r%d", i), Methods: []string{"AppGet"}})
+
+		if r.Errno != ERRNO_OK {
+			t.Fatalf("RoleSet %d %s", r.Errno, r.Errmsg)
+		}
+	}
+
+	pages := map[string]func(cursor string) (int32, []string, string, *pb.Page){
+		"user": func(cursor string) (int32, []string, string, *pb.Page) {
+			rs, _ := s.UserQuery(c, &pb.UserQueryTask{N: 2, Cursor: cursor, Count: true})
+			ids := []string{}
+			for _, v := range rs.Items {
+				ids = append(ids, v.Id)
+			}
+			return rs.Errno, ids, rs.Cursor, rs.Page
+		},
+		"role": func(cursor string) (int32, []string, string, *pb.Page) {
+			rs, _ := s.RoleQuery(c, &pb.RoleQueryTask{N: 2, Cursor: cursor, Count: true})
+			ids := []string{}
+			for _, v := range rs.Items {
+				ids = append(ids, v.Name)
+			}
+			return rs.Errno, ids, rs.Cursor, rs.Page
+		},
+		"audit": func(cursor string) (int32, []string, string, *pb.Page) {
+			rs, _ := s.AuditQuery(c, &pb.AuditQueryTask{N: 2, Cursor: cursor, Count: true})
+			ids := []string{}
+			for _, v := range rs.Items {
+				ids = append(ids, v.Id)
+			}
+			return rs.Errno, ids, rs.Cursor, rs.Page
+		},
+	}
+
+	for name, fn := range pages {
+
+		seen := map[string]bool{}
+		cursor := ""
+		total := int32(-1)
+
+		for i := 0; i < 20; i++ {
+
+			errno, ids, next, page := fn(cursor)
+
+			if errno != ERRNO_OK {
+				t.Fatalf("%s query %d", name, errno)
+			}
+
+			if page == nil {
+				t.Fatalf("%s query without page", name)
+			}
+
+			total = page.TotalCount
+
+			for _, id := range ids {
+				if seen[id] {
+					t.Fatalf("%s duplicate %s", name, id)
+				}
+				seen[id] = true
+			}
+
+			if next == "" {
+				break
+			}
+
+			cursor = next
+		}
+
+		if total < 5 || int32(len(seen)) != total {
+			t.Fatalf("%s paged %d of %d", name, len(seen), total)
+		}
+	}
+
+	u, _ := s.UserQuery(c, &pb.UserQueryTask{P: 1, Cursor: "x"})
+
+	if u.Errno != ERRNO_INPUT_DATA {
+		t.Fatalf("UserQuery p with cursor %d", u.Errno)
+	}
+
+	r, _ := s.RoleQuery(c, &pb.RoleQueryTask{Cursor: "x"})
+
+	if r.Errno != ERRNO_INPUT_DATA {
+		t.Fatalf("RoleQuery invalid cursor %d", r.Errno)
+	}
+
+	a, _ := s.AuditQuery(c, &pb.AuditQueryTask{P: 2, N: 2})
+
+	if a.Errno != ERRNO_OK || a.Page.P != 2 || len(a.Items) != 2 {
+		t.Fatalf("AuditQuery page %d %v", a.Errno, a.Page)
+	}
+}
+
+/**
+* 内置角色按方法授权，绑定的 appid/cid 为空时对全部资源生效
+**/
+func TestCheckRole(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	err := initRoles(c, app)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uids := map[string]string{}
+
+	for _, name := range []string{"viewer", "dev", "devAll", "op", "opAll", "none"} {
+
+		u, _ := s.UserCreate(c, &pb.UserCreateTask{Title: name})
+
+		if u.Errno != ERRNO_OK {
+			t.Fatalf("UserCreate %d %s", u.Errno, u.Errmsg)
+		}
+
+		uids[name] = u.Data.Id
+	}
+
+	bindings := []*pb.RoleBindingAddTask{
+		{Uid: uids["viewer"], Role: "viewer"},
+		{Uid: uids["dev"], Role: "developer", Appid: "a1"},
+		{Uid: uids["devAll"], Role: "developer"},
+		{Uid: uids["op"], Role: "operator", Cid: "c1"},
+		{Uid: uids["opAll"], Role: "operator", Appid: "a1"},
+	}
+
+	for _, b := range bindings {
+
+		err = app.Store.Collection("role_binding").Create(c, bson.D{bson.E{"_id", app.NewID()},
+			bson.E{"uid", b.Uid},
+			bson.E{"role", b.Role},
+			bson.E{"appid", b.Appid},
+			bson.E{"cid", b.Cid}})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := []struct {
+		user   string
+		method string
+		req    interface{}
+		ok     bool
+	}{
+		/* viewer 只读，全部资源 */
+		{"viewer", "AppGet", &pb.AppGetTask{Appid: "a1"}, true},
+		{"viewer", "AppQuery", &pb.AppQueryTask{}, true},
+		{"viewer", "ContainerGet", &pb.ContainerGetTask{Cid: "c9"}, true},
+		{"viewer", "VerCreate", &pb.VerCreateTask{Appid: "a1"}, false},
+		{"viewer", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a1"}, false},
+		{"viewer", "RoleSet", &pb.RoleSetTask{}, false},
+
+		/* developer 限定 appid */
+		{"dev", "VerCreate", &pb.VerCreateTask{Appid: "a1"}, true},
+		{"dev", "VerUpURL", &pb.VerUpURLTask{Appid: "a1"}, true},
+		{"dev", "AppGet", &pb.AppGetTask{Appid: "a1"}, true},
+		{"dev", "VerCreate", &pb.VerCreateTask{Appid: "a2"}, false},
+		{"dev", "VerCreate", &pb.VerCreateTask{}, false},
+		{"dev", "AppQuery", &pb.AppQueryTask{}, false},
+		{"dev", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a1"}, false},
+		{"dev", "RolloutCreate", &pb.RolloutCreateTask{Appid: "a1"}, false},
+
+		/* developer 未限定 appid */
+		{"devAll", "VerCreate", &pb.VerCreateTask{Appid: "a2"}, true},
+		{"devAll", "ChannelSet", &pb.ChannelSetTask{Appid: "a3"}, true},
+		{"devAll", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a1"}, false},
+
+		/* operator 限定 cid */
+		{"op", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a9"}, true},
+		{"op", "ContainerSet", &pb.ContainerSetTask{Cid: "c1"}, true},
+		{"op", "AcSet", &pb.AcSetTask{Cid: "c2", Appid: "a9"}, false},
+		{"op", "AcSet", &pb.AcSetTask{Appid: "a9"}, false},
+		{"op", "VerCreate", &pb.VerCreateTask{Appid: "a9"}, false},
+
+		/* operator 限定 appid，cid 为空时全部容器 */
+		{"opAll", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a1"}, true},
+		{"opAll", "AcSet", &pb.AcSetTask{Cid: "c2", Appid: "a1"}, true},
+		{"opAll", "AcSet", &pb.AcSetTask{Cid: "c2", Appid: "a2"}, false},
+		{"opAll", "RolloutCreate", &pb.RolloutCreateTask{Appid: "a1"}, true},
+
+		/* 其他用户的绑定不生效 */
+		{"none", "AppGet", &pb.AppGetTask{Appid: "a1"}, false},
+		{"none", "VerCreate", &pb.VerCreateTask{Appid: "a1"}, false},
+		{"none", "AcSet", &pb.AcSetTask{Cid: "c1", Appid: "a1"}, false},
+
+		/* 未定义的方法 */
+		{"devAll", "Nope", &pb.AppGetTask{Appid: "a1"}, false},
+	}
+
+	for i, e := range cases {
+
+		ok, err := checkRole(c, app, uids[e.user], e.method, e.req)
+
+		if err != nil {
+			t.Fatalf("case %d: %v", i, err)
+		}
+
+		if ok != e.ok {
+			t.Errorf("case %d %s %s %v: got %v want %v", i, e.user, e.method, e.req, ok, e.ok)
+		}
+	}
+
+	/* 修改角色后按新的方法授权 */
+
+	r, _ := s.RoleSet(c, &pb.RoleSetTask{Name: "viewer", Methods: []string{"AppGet"}})
+
+	if r.Errno != ERRNO_OK {
+		t.Fatalf("RoleSet %d %s", r.Errno, r.Errmsg)
+	}
+
+	ok, err := checkRole(c, app, uids["viewer"], "AppQuery", &pb.AppQueryTask{})
+
+	if err != nil || ok {
+		t.Fatalf("checkRole after RoleSet %v %v", ok, err)
+	}
+}
@@ -0,0 +1,158 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 获取满足版本范围的最高版本，不存在时返回 nil
+**/
+func resolveVer(c context.Context, app *AppService, appid string, r SemverRange, published bool) (bson.M, error) {
+
+	where := bson.D{bson.E{"appid", appid}, bson.E{"vkey", bson.D{bson.E{"$exists", true}}}}
+
+	if published {
+		where = append(where, bson.E{"status", int32(pb.VerStatus_VER_PUBLISHED)})
+	} else {
+		where = append(where, bson.E{"status", bson.D{bson.E{"$ne", int32(pb.VerStatus_VER_YANKED)}}})
+	}
+
+	q := &Query{Where: where,
+		Sort: bson.D{bson.E{"vkey", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}},
+		N:    100}
+
+	for {
+
+		rs, err := app.Store.Ver().Query(c, q)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range rs.Items {
+
+			v, err := ParseSemver(dynamic.StringValue(item["ver"], ""))
+
+			if err != nil {
+				continue
+			}
+
+			if r.Match(v) {
+				return item, nil
+			}
+		}
+
+		if rs.Cursor == "" {
+			return nil, nil
+		}
+
+		q.Cursor = rs.Cursor
+	}
+}
+
+/**
+* 容器应用的版本可为范围，添加或修改时解析为满足范围的最高版本并固定，返回版本及范围
+* 非语义化版本且无法解析为范围时原样返回
+**/
+func resolveAcVer(c context.Context, app *AppService, appid string, ver string) (string, string, int32, error) {
+
+	if ver == "" {
+		return "", "", ERRNO_OK, nil
+	}
+
+	if _, err := ParseSemver(ver); err == nil {
+		return ver, "", ERRNO_OK, nil
+	}
+
+	r, err := ParseSemverRange(ver)
+
+	if err != nil {
+		return ver, "", ERRNO_OK, nil
+	}
+
+	rs, err := resolveVer(c, app, appid, r, app.AcPublished)
+
+	if err != nil {
+		return "", "", ERRNO_INTERNAL_SERVER, err
+	}
+
+	if rs == nil {
+		return "", "", ERRNO_NOT_FOUND, fmt.Errorf("not found ver %s/%s", appid, ver)
+	}
+
+	return dynamic.StringValue(rs["ver"], ""), ver, ERRNO_OK, nil
+}
+
+/**
+* 为历史版本补充排序键
+**/
+func backfillVkeys(c context.Context, app *AppService) error {
+
+	items, err := app.Store.Collection("ver").Find(c, bson.D{bson.E{"vkey", bson.D{bson.E{"$exists", false}}}}, nil, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+
+		v, err := ParseSemver(dynamic.StringValue(item["ver"], ""))
+
+		if err != nil {
+			continue
+		}
+
+		_, err = app.Store.Collection("ver").Update(c, bson.D{bson.E{"appid", item["appid"]}, bson.E{"ver", item["ver"]}}, &Update{Set: bson.D{bson.E{"vkey", v.Key()}}, KeepRev: true})
+
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *server) VerResolve(c context.Context, task *pb.VerResolveTask) (*pb.VerResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	r, err := ParseSemverRange(task.Range)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := resolveVer(c, app, task.Appid, r, task.Published)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if rs == nil {
+		return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ver"}, nil
+	}
+
+	a := &pb.Ver{}
+
+	setVer(a, rs)
+
+	return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
+}
@@ -0,0 +1,52 @@
+package srv
+
+import (
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+)
+
+/**
+* 版本范围仅在添加或修改容器应用时解析并固定
+**/
+func TestAcVerRange(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+
+	rs, _ := s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "^1.0.0"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.0.0" || rs.Data.VerRange != "^1.0.0" {
+		t.Fatalf("AcAdd range %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+	s.VerSet(c, &pb.VerSetTask{Appid: appid, Ver: "1.1.0", Status: "VER_PUBLISHED"})
+
+	doc, err := app.Store.Ac().Get(c, cid, appid)
+
+	if err != nil || doc["ver"] != "1.0.0" {
+		t.Fatalf("ac moved by ver change %v %v", doc, err)
+	}
+
+	rs, _ = s.AcSet(c, &pb.AcSetTask{Cid: cid, Appid: appid, Ver: "^1.0.0"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.1.0" || rs.Data.VerRange != "^1.0.0" {
+		t.Fatalf("AcSet range %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.AcSet(c, &pb.AcSetTask{Cid: cid, Appid: appid, Ver: "^2.0.0"})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("AcSet unresolved range %d", rs.Errno)
+	}
+}
@@ -0,0 +1,16 @@
+package srv
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 附加期望版本号条件，rev <= 0 时不校验
+**/
+func withRev(filter bson.D, rev int32) bson.D {
+	if rev <= 0 {
+		return filter
+	}
+	rs := append(bson.D{}, filter...)
+	return append(rs, bson.E{"rev", rev})
+}
@@ -0,0 +1,883 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	AUDIT_ROLLOUT = "rollout"
+)
+
+const (
+	ROLLOUT_RUNNING = 1
+	ROLLOUT_PAUSED  = 2
+	ROLLOUT_ABORTED = 3
+	ROLLOUT_DONE    = 4
+)
+
+const (
+	ROLLOUT_ITEM_PENDING  = 0
+	ROLLOUT_ITEM_DONE     = 1
+	ROLLOUT_ITEM_SKIPPED  = 2
+	ROLLOUT_ITEM_FAILED   = 3
+	ROLLOUT_ITEM_REVERTED = 4
+)
+
+func setRollout(a *pb.Rollout, rs bson.M) {
+	a.Id = dynamic.StringValue(rs["_id"], "")
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.FromVer = dynamic.StringValue(rs["from_ver"], "")
+	a.ToVer = dynamic.StringValue(rs["to_ver"], "")
+	a.Title = dynamic.StringValue(rs["title"], "")
+	a.Status = int32(dynamic.IntValue(rs["status"], 0))
+	a.Wave = int32(dynamic.IntValue(rs["wave"], 0))
+	a.Waves = []*pb.RolloutWave{}
+	dynamic.Each(rs["waves"], func(_ interface{}, value interface{}) bool {
+		w := &pb.RolloutWave{Percent: int32(dynamic.IntValue(dynamic.Get(value, "percent"), 0)), Cids: []string{}}
+		dynamic.Each(dynamic.Get(value, "cids"), func(_ interface{}, cid interface{}) bool {
+			w.Cids = append(w.Cids, dynamic.StringValue(cid, ""))
+			return true
+		})
+		a.Waves = append(a.Waves, w)
+		return true
+	})
+	a.Total = int32(dynamic.IntValue(rs["total"], 0))
+	a.Done = int32(dynamic.IntValue(rs["done"], 0))
+	a.Skipped = int32(dynamic.IntValue(rs["skipped"], 0))
+	a.Failed = int32(dynamic.IntValue(rs["failed"], 0))
+	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+	a.Mtime = int32(dynamic.IntValue(rs["mtime"], 0))
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
+}
+
+func toRolloutItems(rs []bson.M) []*pb.Rollout {
+	vs := []*pb.Rollout{}
+	for _, r := range rs {
+		v := &pb.Rollout{}
+		setRollout(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func setRolloutItem(a *pb.RolloutItem, rs bson.M) {
+	a.RolloutId = dynamic.StringValue(rs["rollout_id"], "")
+	a.Cid = dynamic.StringValue(rs["cid"], "")
+	a.Appid = dynamic.StringValue(rs["appid"], "")
+	a.Wave = int32(dynamic.IntValue(rs["wave"], 0))
+	a.FromVer = dynamic.StringValue(rs["from_ver"], "")
+	a.Status = int32(dynamic.IntValue(rs["status"], 0))
+	a.Errmsg = dynamic.StringValue(rs["errmsg"], "")
+	a.Mtime = int32(dynamic.IntValue(rs["mtime"], 0))
+}
+
+func toRolloutItemItems(rs []bson.M) []*pb.RolloutItem {
+	vs := []*pb.RolloutItem{}
+	for _, r := range rs {
+		v := &pb.RolloutItem{}
+		setRolloutItem(v, r)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+/**
+* 将容器分配到各批次，cids 指定的容器优先，percent 为截至该批次累计切换的容器比例
+* 未分配的容器追加到最后一个 100% 的批次
+**/
+func planRollout(cids []string, waves []*pb.RolloutWave) ([]*pb.RolloutWave, map[string]int, error) {
+
+	bound := map[string]bool{}
+
+	for _, cid := range cids {
+		bound[cid] = true
+	}
+
+	assigned := map[string]int{}
+	rs := []*pb.RolloutWave{}
+	total := len(cids)
+
+	for i, w := range waves {
+
+		if w.Percent < 0 || w.Percent > 100 {
+			return nil, nil, fmt.Errorf("invalid wave percent %d", w.Percent)
+		}
+
+		for _, cid := range w.Cids {
+			if !bound[cid] {
+				return nil, nil, fmt.Errorf("container %s not bound", cid)
+			}
+			if _, ok := assigned[cid]; !ok {
+				assigned[cid] = i
+			}
+		}
+
+		want := (total*int(w.Percent) + 99) / 100
+
+		for _, cid := range cids {
+			if len(assigned) >= want {
+				break
+			}
+			if _, ok := assigned[cid]; !ok {
+				assigned[cid] = i
+			}
+		}
+
+		rs = append(rs, &pb.RolloutWave{Percent: w.Percent, Cids: w.Cids})
+	}
+
+	if len(assigned) < total {
+
+		i := len(rs)
+
+		for _, cid := range cids {
+			if _, ok := assigned[cid]; !ok {
+				assigned[cid] = i
+			}
+		}
+
+		rs = append(rs, &pb.RolloutWave{Percent: 100})
+	}
+
+	return rs, assigned, nil
+}
+
+func rolloutWaveDocs(waves []*pb.RolloutWave) bson.A {
+	vs := bson.A{}
+	for _, w := range waves {
+		cids := w.Cids
+		if cids == nil {
+			cids = []string{}
+		}
+		vs = append(vs, bson.D{bson.E{"percent", w.Percent}, bson.E{"cids", cids}})
+	}
+	return vs
+}
+
+/**
+* 解析逗号分隔的状态值
+**/
+func parseStatuses(s string) (bson.A, error) {
+	vs := bson.A{}
+	for _, v := range strings.Split(s, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid status %s", v)
+		}
+		vs = append(vs, n)
+	}
+	return vs, nil
+}
+
+/**
+* 切换灰度批次中的容器应用，容器应用版本已变化时跳过
+* 灰度项状态与容器应用在同一事务中修改，灰度项写入失败时返回错误，容器应用不变
+**/
+func rolloutAc(c context.Context, ctx micro.Context, app *AppService, method string, item bson.M, ver string, set bson.D, status int32) error {
+
+	db_ac := app.Store.Collection("ac")
+
+	cid := dynamic.StringValue(item["cid"], "")
+	appid := dynamic.StringValue(item["appid"], "")
+	filter := bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}}
+
+	setItem := func(c context.Context, status int32, errmsg string) error {
+		_, err := app.Store.Collection("rollout_item").Update(c, bson.D{bson.E{"rollout_id", item["rollout_id"]}, bson.E{"cid", cid}},
+			&Update{Set: bson.D{bson.E{"status", status}, bson.E{"errmsg", errmsg}, bson.E{"mtime", int32(time.Now().Unix())}}, KeepRev: true})
+		return err
+	}
+
+	var before bson.M
+	var after bson.M
+	var itemErr error
+
+	err := app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		before = findDoc(c, db_ac, filter)
+
+		after, err = db_ac.Update(c, append(filter, bson.E{"ver", ver}), &Update{Set: set})
+
+		if err == ErrNotFound {
+			itemErr = setItem(c, ROLLOUT_ITEM_SKIPPED, "ac changed")
+			return itemErr
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = acHistory(c, app, method, before, after)
+
+		if err != nil {
+			return err
+		}
+
+		err = audit(c, ctx, app, method, AUDIT_AC, cid+"/"+appid, before, after)
+
+		if err != nil {
+			return err
+		}
+
+		itemErr = setItem(c, status, "")
+
+		return itemErr
+	})
+
+	if itemErr != nil {
+		return itemErr
+	}
+
+	if err != nil {
+		// 切换已回滚，记录失败后下次执行时重试
+		return setItem(c, ROLLOUT_ITEM_FAILED, err.Error())
+	}
+
+	return nil
+}
+
+/**
+* 应用进行中的灰度发布标记，_id 为 appid，唯一键保证同一应用只有一个进行中的灰度发布
+**/
+func lockRollout(c context.Context, app *AppService, appid string, id string) error {
+
+	ok, err := exists(c, app.Store.Collection("rollout"), bson.D{bson.E{"appid", appid}, bson.E{"status", bson.D{bson.E{"$in", bson.A{ROLLOUT_RUNNING, ROLLOUT_PAUSED}}}}})
+
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		return ErrDuplicate
+	}
+
+	return app.Store.Collection("rollout_active").Create(c, bson.D{bson.E{"_id", appid}, bson.E{"rollout_id", id}, bson.E{"ctime", int32(time.Now().Unix())}})
+}
+
+/**
+* 灰度发布结束(完成或终止)时移除标记
+**/
+func unlockRollout(c context.Context, app *AppService, appid string, id string) error {
+
+	_, err := app.Store.Collection("rollout_active").Remove(c, bson.D{bson.E{"_id", appid}, bson.E{"rollout_id", id}}, 0)
+
+	if err == ErrNotFound {
+		return nil
+	}
+
+	return err
+}
+
+/**
+* 修改灰度发布状态，仅允许从 from 中的状态切换
+**/
+func setRolloutStatus(c context.Context, ctx micro.Context, app *AppService, method string, appid string, id string, from bson.A, to int32) (bson.M, int32, error) {
+
+	db_rollout := app.Store.Collection("rollout")
+
+	filter := bson.D{bson.E{"_id", id}, bson.E{"appid", appid}}
+
+	var before bson.M
+	var rs bson.M
+
+	err := app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		before = findDoc(c, db_rollout, filter)
+
+		if before == nil {
+			return ErrNotFound
+		}
+
+		rs, err = db_rollout.Update(c, append(filter, bson.E{"status", bson.D{bson.E{"$in", from}}}),
+			&Update{Set: bson.D{bson.E{"status", to}, bson.E{"mtime", int32(time.Now().Unix())}}})
+
+		if err != nil {
+			return err
+		}
+
+		if to == ROLLOUT_ABORTED || to == ROLLOUT_DONE {
+
+			err = unlockRollout(c, app, appid, id)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return audit(c, ctx, app, method, AUDIT_ROLLOUT, id, before, rs)
+	})
+
+	if err != nil {
+		if err == ErrNotFound {
+			if before == nil {
+				return nil, ERRNO_NOT_FOUND, fmt.Errorf("not found rollout")
+			}
+			return nil, ERRNO_CONFLICT, fmt.Errorf("rollout status %d", dynamic.IntValue(before["status"], 0))
+		}
+		return nil, ERRNO_INTERNAL_SERVER, err
+	}
+
+	return rs, ERRNO_OK, nil
+}
+
+func (s *server) RolloutCreate(c context.Context, task *pb.RolloutCreateTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.ToVer == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param toVer"}, nil
+	}
+
+	if task.FromVer == task.ToVer {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "param fromVer equals toVer"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	errno, err := checkApp(c, app, task.Appid)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	errno, err = checkVer(c, app, task.Appid, task.ToVer)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	db_rollout := app.Store.Collection("rollout")
+
+	filter := bson.D{bson.E{"appid", task.Appid}}
+
+	if task.FromVer != "" {
+		filter = append(filter, bson.E{"ver", task.FromVer})
+	} else {
+		filter = append(filter, bson.E{"ver", bson.D{bson.E{"$ne", task.ToVer}}})
+	}
+
+	acs, err := app.Store.Collection("ac").Find(c, filter, bson.D{bson.E{"cid", 1}}, 0)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if len(acs) == 0 {
+		return &pb.RolloutResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ac"}, nil
+	}
+
+	cids := []string{}
+
+	for _, ac := range acs {
+		cids = append(cids, dynamic.StringValue(ac["cid"], ""))
+	}
+
+	sort.Strings(cids)
+
+	waves, assigned, err := planRollout(cids, task.Waves)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	id := app.NewID()
+	now := int32(time.Now().Unix())
+
+	doc := bson.D{bson.E{"_id", id},
+		bson.E{"appid", task.Appid},
+		bson.E{"from_ver", task.FromVer},
+		bson.E{"to_ver", task.ToVer},
+		bson.E{"title", task.Title},
+		bson.E{"status", ROLLOUT_RUNNING},
+		bson.E{"wave", 0},
+		bson.E{"waves", rolloutWaveDocs(waves)},
+		bson.E{"total", len(acs)},
+		bson.E{"done", 0},
+		bson.E{"skipped", 0},
+		bson.E{"failed", 0},
+		bson.E{"actor", getActor(c)},
+		bson.E{"ctime", now},
+		bson.E{"mtime", now},
+		bson.E{"rev", 1}}
+
+	items := []bson.D{}
+
+	for _, ac := range acs {
+		cid := dynamic.StringValue(ac["cid"], "")
+		items = append(items, bson.D{bson.E{"rollout_id", id},
+			bson.E{"cid", cid},
+			bson.E{"appid", task.Appid},
+			bson.E{"wave", assigned[cid]},
+			bson.E{"from_ver", dynamic.StringValue(ac["ver"], "")},
+			bson.E{"from_range", dynamic.StringValue(ac["range"], "")},
+			bson.E{"from_channel", dynamic.StringValue(ac["channel"], "")},
+			bson.E{"status", ROLLOUT_ITEM_PENDING},
+			bson.E{"errmsg", ""},
+			bson.E{"mtime", now}})
+	}
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		err := lockRollout(c, app, task.Appid, id)
+
+		if err != nil {
+			return err
+		}
+
+		err = db_rollout.Create(c, doc)
+
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+
+			err = app.Store.Collection("rollout_item").Create(c, item)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return audit(c, ctx, app, "RolloutCreate", AUDIT_ROLLOUT, id, nil, doc)
+	})
+
+	if err == ErrDuplicate {
+		return &pb.RolloutResult{Errno: ERRNO_CONFLICT, Errmsg: "rollout in progress"}, nil
+	}
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.Rollout{Id: id, Appid: task.Appid, FromVer: task.FromVer, ToVer: task.ToVer, Title: task.Title,
+		Status: ROLLOUT_RUNNING, Waves: waves, Total: int32(len(acs)), Ctime: now, Mtime: now, Rev: 1}
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutStep(c context.Context, task *pb.RolloutStepTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	db_rollout := app.Store.Collection("rollout")
+
+	filter := bson.D{bson.E{"_id", task.Id}, bson.E{"appid", task.Appid}}
+
+	before := findDoc(c, db_rollout, filter)
+
+	if before == nil {
+		return &pb.RolloutResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found rollout"}, nil
+	}
+
+	rev := int32(dynamic.IntValue(before["rev"], 0))
+
+	if task.ExpectedRev > 0 && task.ExpectedRev != rev {
+		return &pb.RolloutResult{Errno: ERRNO_CONFLICT, Errmsg: "rollout rev conflict"}, nil
+	}
+
+	if dynamic.IntValue(before["status"], 0) != ROLLOUT_RUNNING {
+		return &pb.RolloutResult{Errno: ERRNO_CONFLICT, Errmsg: "rollout not running"}, nil
+	}
+
+	toVer := dynamic.StringValue(before["to_ver"], "")
+
+	errno, err := checkVer(c, app, task.Appid, toVer)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	wave := int32(dynamic.IntValue(before["wave"], 0))
+
+	// 以 rev 占用本次执行，并发的 RolloutStep 返回冲突
+	_, err = db_rollout.Update(c, append(filter, bson.E{"status", ROLLOUT_RUNNING}, bson.E{"wave", wave}),
+		&Update{Set: bson.D{bson.E{"mtime", int32(time.Now().Unix())}}, Rev: rev})
+
+	if err != nil {
+		if err == ErrNotFound || err == ErrConflict {
+			return &pb.RolloutResult{Errno: ERRNO_CONFLICT, Errmsg: "rollout rev conflict"}, nil
+		}
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	db_item := app.Store.Collection("rollout_item")
+
+	// 当前及之前批次中未完成(待执行、失败)的容器应用
+	unfinished := bson.D{bson.E{"rollout_id", task.Id},
+		bson.E{"wave", bson.D{bson.E{"$lte", wave}}},
+		bson.E{"status", bson.D{bson.E{"$in", bson.A{ROLLOUT_ITEM_PENDING, ROLLOUT_ITEM_FAILED}}}}}
+
+	items, err := db_item.Find(c, unfinished, bson.D{bson.E{"wave", 1}, bson.E{"cid", 1}}, 0)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	set := bson.D{bson.E{"ver", toVer}, bson.E{"range", ""}, bson.E{"channel", ""}}
+
+	for _, item := range items {
+
+		err = rolloutAc(c, ctx, app, "RolloutStep", item, dynamic.StringValue(item["from_ver"], ""), set, ROLLOUT_ITEM_DONE)
+
+		if err != nil {
+			return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+	}
+
+	// 批次内全部完成或跳过后才进入下一批次，否则下次执行时重试
+	n, err := db_item.Count(c, unfinished)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	update := bson.D{bson.E{"mtime", int32(time.Now().Unix())}}
+
+	for _, v := range []struct {
+		key    string
+		status int32
+	}{{"done", ROLLOUT_ITEM_DONE}, {"skipped", ROLLOUT_ITEM_SKIPPED}, {"failed", ROLLOUT_ITEM_FAILED}} {
+
+		count, err := db_item.Count(c, bson.D{bson.E{"rollout_id", task.Id}, bson.E{"status", v.status}})
+
+		if err != nil {
+			return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+
+		update = append(update, bson.E{v.key, int32(count)})
+	}
+
+	counts := update
+
+	b := &pb.Rollout{}
+
+	setRollout(b, before)
+
+	if n == 0 {
+
+		update = append(update[:len(counts):len(counts)], bson.E{"wave", wave + 1})
+
+		if int(wave)+1 >= len(b.Waves) {
+			update = append(update, bson.E{"status", ROLLOUT_DONE})
+		}
+	}
+
+	var rs bson.M
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		var err error
+
+		rs, err = db_rollout.Update(c, append(filter, bson.E{"status", ROLLOUT_RUNNING}, bson.E{"wave", wave}), &Update{Set: update})
+
+		if err == ErrNotFound {
+			// 执行期间被暂停或终止，只更新计数
+			rs, err = db_rollout.Update(c, filter, &Update{Set: counts})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if dynamic.IntValue(rs["status"], 0) == ROLLOUT_DONE {
+
+			err = unlockRollout(c, app, task.Appid, task.Id)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return audit(c, ctx, app, "RolloutStep", AUDIT_ROLLOUT, task.Id, before, rs)
+	})
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.Rollout{}
+
+	setRollout(a, rs)
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutPause(c context.Context, task *pb.RolloutPauseTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, errno, err := setRolloutStatus(c, ctx, app, "RolloutPause", task.Appid, task.Id, bson.A{ROLLOUT_RUNNING}, ROLLOUT_PAUSED)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.Rollout{}
+
+	setRollout(a, rs)
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutResume(c context.Context, task *pb.RolloutResumeTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, errno, err := setRolloutStatus(c, ctx, app, "RolloutResume", task.Appid, task.Id, bson.A{ROLLOUT_PAUSED}, ROLLOUT_RUNNING)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	a := &pb.Rollout{}
+
+	setRollout(a, rs)
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutAbort(c context.Context, task *pb.RolloutAbortTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, errno, err := setRolloutStatus(c, ctx, app, "RolloutAbort", task.Appid, task.Id, bson.A{ROLLOUT_RUNNING, ROLLOUT_PAUSED}, ROLLOUT_ABORTED)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
+
+	if task.Revert {
+
+		items, err := app.Store.Collection("rollout_item").Find(c,
+			bson.D{bson.E{"rollout_id", task.Id}, bson.E{"status", ROLLOUT_ITEM_DONE}},
+			bson.D{bson.E{"cid", 1}}, 0)
+
+		if err != nil {
+			return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+
+		toVer := dynamic.StringValue(rs["to_ver"], "")
+
+		for _, item := range items {
+
+			set := bson.D{bson.E{"ver", dynamic.StringValue(item["from_ver"], "")},
+				bson.E{"range", dynamic.StringValue(item["from_range"], "")},
+				bson.E{"channel", dynamic.StringValue(item["from_channel"], "")}}
+
+			err = rolloutAc(c, ctx, app, "RolloutAbort", item, toVer, set, ROLLOUT_ITEM_REVERTED)
+
+			if err != nil {
+				return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			}
+		}
+	}
+
+	a := &pb.Rollout{}
+
+	setRollout(a, rs)
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutGet(c context.Context, task *pb.RolloutGetTask) (*pb.RolloutResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	rs, err := app.Store.Collection("rollout").Get(c, bson.D{bson.E{"_id", task.Id}, bson.E{"appid", task.Appid}})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "rollout")
+		return &pb.RolloutResult{Errno: errno, Errmsg: errmsg}, nil
+	}
+
+	a := &pb.Rollout{}
+
+	setRollout(a, rs)
+
+	return &pb.RolloutResult{Errno: ERRNO_OK, Data: a}, nil
+}
+
+func (s *server) RolloutQuery(c context.Context, task *pb.RolloutQueryTask) (*pb.RolloutQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	filter := bson.D{bson.E{"appid", task.Appid}}
+
+	if task.Status != "" {
+
+		statuses, err := parseStatuses(task.Status)
+
+		if err != nil {
+			return &pb.RolloutQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+		}
+
+		filter = append(filter, bson.E{"status", bson.D{bson.E{"$in", statuses}}})
+	}
+
+	rs, err := app.Store.Collection("rollout").Query(c, &Query{Where: filter, P: task.P, N: task.N})
+
+	if err != nil {
+		return &pb.RolloutQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.RolloutQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toRolloutItems(rs.Items)}, nil
+}
+
+func (s *server) RolloutItemQuery(c context.Context, task *pb.RolloutItemQueryTask) (*pb.RolloutItemQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Appid == "" {
+		return &pb.RolloutItemQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
+
+	if task.Id == "" {
+		return &pb.RolloutItemQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param id"}, nil
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.RolloutItemQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	filter := bson.D{bson.E{"rollout_id", task.Id}, bson.E{"appid", task.Appid}}
+
+	if task.Status != "" {
+
+		statuses, err := parseStatuses(task.Status)
+
+		if err != nil {
+			return &pb.RolloutItemQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+		}
+
+		filter = append(filter, bson.E{"status", bson.D{bson.E{"$in", statuses}}})
+	}
+
+	rs, err := app.Store.Collection("rollout_item").Query(c, &Query{Where: filter, Sort: bson.D{bson.E{"wave", 1}, bson.E{"cid", 1}}, P: task.P, N: task.N})
+
+	if err != nil {
+		return &pb.RolloutItemQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	return &pb.RolloutItemQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toRolloutItemItems(rs.Items)}, nil
+}
@@ -0,0 +1,291 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 指定容器的容器应用更新失败的存储
+**/
+type failAcStore struct {
+	Store
+	cid string
+}
+
+type failAcCollection struct {
+	Collection
+	s *failAcStore
+}
+
+func (s *failAcStore) Collection(name string) Collection {
+	if name == "ac" {
+		return &failAcCollection{Collection: s.Store.Collection(name), s: s}
+	}
+	return s.Store.Collection(name)
+}
+
+func (c *failAcCollection) Update(ctx context.Context, filter bson.D, u *Update) (bson.M, error) {
+	for _, e := range filter {
+		if e.Key == "cid" && e.Value == c.s.cid {
+			return nil, fmt.Errorf("ac unavailable")
+		}
+	}
+	return c.Collection.Update(ctx, filter, u)
+}
+
+/**
+* 指定集合更新失败的存储
+**/
+type failUpdateStore struct {
+	Store
+	name string
+}
+
+type failUpdateCollection struct {
+	Collection
+}
+
+func (s *failUpdateStore) Collection(name string) Collection {
+	if name == s.name {
+		return &failUpdateCollection{Collection: s.Store.Collection(name)}
+	}
+	return s.Store.Collection(name)
+}
+
+func (c *failUpdateCollection) Update(ctx context.Context, filter bson.D, u *Update) (bson.M, error) {
+	return nil, fmt.Errorf("update unavailable")
+}
+
+/**
+* 批次内有失败的容器应用时不进入下一批次，下次执行时重试
+**/
+func TestRolloutStepRetry(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+
+	cids := []string{}
+
+	for i := 0; i < 3; i++ {
+		ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+		s.AcAdd(c, &pb.AcAddTask{Cid: ct.Data.Id, Appid: appid, Ver: "1.0.0"})
+		cids = append(cids, ct.Data.Id)
+	}
+
+	sort.Strings(cids)
+
+	rs, _ := s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, FromVer: "1.0.0", ToVer: "1.1.0", Waves: []*pb.RolloutWave{{Percent: 50}}})
+
+	if rs.Errno != ERRNO_OK || len(rs.Data.Waves) != 2 {
+		t.Fatalf("RolloutCreate %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	id := rs.Data.Id
+
+	store := app.Store
+
+	app.Store = &failAcStore{Store: store, cid: cids[0]}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Wave != 0 || rs.Data.Done != 1 || rs.Data.Failed != 1 || rs.Data.Status != ROLLOUT_RUNNING {
+		t.Fatalf("RolloutStep failed item %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	app.Store = store
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Wave != 1 || rs.Data.Done != 2 || rs.Data.Failed != 0 {
+		t.Fatalf("RolloutStep retry %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Wave != 2 || rs.Data.Done != 3 || rs.Data.Status != ROLLOUT_DONE {
+		t.Fatalf("RolloutStep last %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	for _, cid := range cids {
+
+		ac, err := store.Ac().Get(c, cid, appid)
+
+		if err != nil || ac["ver"] != "1.1.0" {
+			t.Fatalf("ac %s %v %v", cid, ac, err)
+		}
+	}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("RolloutStep done %d", rs.Errno)
+	}
+}
+
+/**
+* 灰度项写入失败时容器应用不切换，重试时不会被误判为跳过
+**/
+func TestRolloutItemTransaction(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+	s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0"})
+
+	rs, _ := s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, FromVer: "1.0.0", ToVer: "1.1.0"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("RolloutCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	id := rs.Data.Id
+
+	store := app.Store
+
+	app.Store = &failUpdateStore{Store: store, name: "rollout_item"}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	app.Store = store
+
+	if rs.Errno != ERRNO_INTERNAL_SERVER {
+		t.Fatalf("RolloutStep item failure %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	ac, err := store.Ac().Get(c, cid, appid)
+
+	if err != nil || ac["ver"] != "1.0.0" {
+		t.Fatalf("ac switched without item %v %v", ac, err)
+	}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Done != 1 || rs.Data.Skipped != 0 || rs.Data.Status != ROLLOUT_DONE {
+		t.Fatalf("RolloutStep retry %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+}
+
+/**
+* 同一应用只有一个进行中的灰度发布，结束后可再次创建
+**/
+func TestRolloutActive(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+	s.AcAdd(c, &pb.AcAddTask{Cid: ct.Data.Id, Appid: appid, Ver: "1.0.0"})
+
+	/* 并发创建的灰度发布已写入标记，尚不可见 */
+
+	err := app.Store.Collection("rollout_active").Create(c, bson.D{bson.E{"_id", appid}, bson.E{"rollout_id", "other"}})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, _ := s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, ToVer: "1.1.0"})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("RolloutCreate with marker %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	n, err := app.Store.Collection("rollout").Count(c, bson.D{bson.E{"appid", appid}})
+
+	if err != nil || n != 0 {
+		t.Fatalf("rollout created with marker %d %v", n, err)
+	}
+
+	app.Store.Collection("rollout_active").Remove(c, bson.D{bson.E{"_id", appid}}, 0)
+
+	rs, _ = s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, ToVer: "1.1.0"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("RolloutCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	id := rs.Data.Id
+
+	rs, _ = s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, ToVer: "1.1.0"})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("RolloutCreate in progress %d", rs.Errno)
+	}
+
+	rs, _ = s.RolloutAbort(c, &pb.RolloutAbortTask{Appid: appid, Id: id})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("RolloutAbort %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.RolloutCreate(c, &pb.RolloutCreateTask{Appid: appid, ToVer: "1.1.0"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("RolloutCreate after abort %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.RolloutStep(c, &pb.RolloutStepTask{Appid: appid, Id: rs.Data.Id})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Status != ROLLOUT_DONE {
+		t.Fatalf("RolloutStep %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	_, err = app.Store.Collection("rollout_active").Get(c, bson.D{bson.E{"_id", appid}})
+
+	if err != ErrNotFound {
+		t.Fatalf("marker after done %v", err)
+	}
+}
+
+func TestParseStatuses(t *testing.T) {
+
+	cases := []struct {
+		s  string
+		vs bson.A
+	}{
+		{"1", bson.A{1}},
+		{"1, 2,3", bson.A{1, 2, 3}},
+		{"running", nil},
+		{"1,", nil},
+		{"1,x", nil},
+	}
+
+	for _, e := range cases {
+
+		vs, err := parseStatuses(e.s)
+
+		if (err == nil) != (e.vs != nil) || fmt.Sprint(vs) != fmt.Sprint(e.vs) {
+			t.Errorf("%q: got %v %v want %v", e.s, vs, err, e.vs)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package srv
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	SEARCH_MAX_LENGTH      = 256
+	REGEX_MAX_LENGTH       = 128
+	REGEX_MAX_QUANTIFIERS  = 8
+	REGEX_MAX_REPEAT_COUNT = 100
+)
+
+/**
+* 统计量词数量，拒绝嵌套量词、量词内的分支及过大的重复次数，避免回溯爆炸
+**/
+func walkRegex(re *syntax.Regexp, repeat bool, count *int) error {
+
+	switch re.Op {
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+
+		if repeat {
+			return fmt.Errorf("regex nested quantifier not allowed")
+		}
+
+		if re.Op == syntax.OpRepeat && (re.Min > REGEX_MAX_REPEAT_COUNT || re.Max > REGEX_MAX_REPEAT_COUNT) {
+			return fmt.Errorf("regex repeat count exceeds %d", REGEX_MAX_REPEAT_COUNT)
+		}
+
+		*count = *count + 1
+
+		if *count > REGEX_MAX_QUANTIFIERS {
+			return fmt.Errorf("regex quantifiers exceed %d", REGEX_MAX_QUANTIFIERS)
+		}
+
+		repeat = true
+
+	case syntax.OpAlternate:
+
+		if repeat {
+			return fmt.Errorf("regex alternation in quantifier not allowed")
+		}
+	}
+
+	for _, sub := range re.Sub {
+		err := walkRegex(sub, repeat, count)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/**
+* 校验调用方提供的正则表达式，不支持反向引用及环视
+**/
+func checkRegex(q string) error {
+
+	if len(q) > REGEX_MAX_LENGTH {
+		return fmt.Errorf("regex length exceeds %d", REGEX_MAX_LENGTH)
+	}
+
+	re, err := syntax.Parse(q, syntax.Perl)
+
+	if err != nil {
+		return err
+	}
+
+	count := 0
+
+	return walkRegex(re, false, &count)
+}
+
+/**
+* 将关键字转换为 title 查询条件，默认按字面量匹配，MATCH_REGEX 时才作为正则表达式
+* MATCH_TEXT 返回 $text 条件，需放在查询条件顶层，全文检索始终忽略大小写
+**/
+func searchCond(q string, match pb.FilterMatch, ignoreCase bool) (bson.E, error) {
+
+	if len(q) > SEARCH_MAX_LENGTH {
+		return bson.E{}, fmt.Errorf("q length exceeds %d", SEARCH_MAX_LENGTH)
+	}
+
+	var pattern string
+
+	switch match {
+	case pb.FilterMatch_MATCH_TEXT:
+		return bson.E{"$text", bson.D{bson.E{"$search", q}}}, nil
+	case pb.FilterMatch_MATCH_REGEX:
+		err := checkRegex(q)
+		if err != nil {
+			return bson.E{}, err
+		}
+		pattern = q
+	case pb.FilterMatch_MATCH_PREFIX:
+		pattern = "^" + regexp.QuoteMeta(q)
+	case pb.FilterMatch_MATCH_CONTAINS:
+		pattern = regexp.QuoteMeta(q)
+	default:
+		return bson.E{}, fmt.Errorf("invalid match %d", match)
+	}
+
+	regex := bson.D{bson.E{"$regex", pattern}}
+
+	if ignoreCase {
+		regex = append(regex, bson.E{"$options", "i"})
+	}
+
+	return bson.E{"title", regex}, nil
+}
@@ -0,0 +1,106 @@
+package srv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 非正则匹配时关键字中的元字符按字面量匹配
+**/
+func TestSearchCond(t *testing.T) {
+
+	cases := []struct {
+		q          string
+		match      pb.FilterMatch
+		ignoreCase bool
+		title      string
+		ok         bool
+		matched    bool
+	}{
+		{"a.b", pb.FilterMatch_MATCH_CONTAINS, false, "xa.bx", true, true},
+		{"a.b", pb.FilterMatch_MATCH_CONTAINS, false, "axb", true, false},
+		{".*", pb.FilterMatch_MATCH_CONTAINS, false, "anything", true, false},
+		{".*", pb.FilterMatch_MATCH_CONTAINS, false, "x.*x", true, true},
+		{"(a+)+$", pb.FilterMatch_MATCH_CONTAINS, false, "(a+)+$", true, true},
+		{"[", pb.FilterMatch_MATCH_CONTAINS, false, "a[b", true, true},
+		{"hello", pb.FilterMatch_MATCH_CONTAINS, false, "Hello", true, false},
+		{"hello", pb.FilterMatch_MATCH_CONTAINS, true, "Hello", true, true},
+		{"a|b", pb.FilterMatch_MATCH_PREFIX, false, "a|bc", true, true},
+		{"a|b", pb.FilterMatch_MATCH_PREFIX, false, "b", true, false},
+		{"app", pb.FilterMatch_MATCH_PREFIX, false, "my app", true, false},
+		{"^a\\d", pb.FilterMatch_MATCH_REGEX, false, "a1", true, true},
+		{"^a\\d", pb.FilterMatch_MATCH_REGEX, false, "b1", true, false},
+		{"(a+)+$", pb.FilterMatch_MATCH_REGEX, false, "", false, false},
+		{"[", pb.FilterMatch_MATCH_REGEX, false, "", false, false},
+		{strings.Repeat("a", SEARCH_MAX_LENGTH), pb.FilterMatch_MATCH_CONTAINS, false, strings.Repeat("a", SEARCH_MAX_LENGTH), true, true},
+		{strings.Repeat("a", SEARCH_MAX_LENGTH+1), pb.FilterMatch_MATCH_CONTAINS, false, "", false, false},
+		{"x", pb.FilterMatch(99), false, "", false, false},
+	}
+
+	for i, e := range cases {
+
+		cond, err := searchCond(e.q, e.match, e.ignoreCase)
+
+		if (err == nil) != e.ok {
+			t.Fatalf("case %d %q: got %v want ok %v", i, e.q, err, e.ok)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		r, err := matchDoc(bson.M{"title": e.title}, bson.D{cond})
+
+		if err != nil || r != e.matched {
+			t.Fatalf("case %d %q match %q: got %v %v want %v", i, e.q, e.title, r, err, e.matched)
+		}
+	}
+
+	cond, err := searchCond("hello world", pb.FilterMatch_MATCH_TEXT, false)
+
+	if err != nil || cond.Key != "$text" {
+		t.Fatalf("text cond %v %v", cond, err)
+	}
+}
+
+/**
+* 正则表达式的长度及复杂度限制
+**/
+func TestCheckRegex(t *testing.T) {
+
+	cases := []struct {
+		q  string
+		ok bool
+	}{
+		{"^abc$", true},
+		{"a+b*c?", true},
+		{"a{1,100}", true},
+		{"(ab|cd)", true},
+		{strings.Repeat("a", REGEX_MAX_LENGTH), true},
+		{strings.Repeat("a", REGEX_MAX_LENGTH+1), false},
+		{strings.Repeat("a+", REGEX_MAX_QUANTIFIERS), true},
+		{strings.Repeat("a+", REGEX_MAX_QUANTIFIERS+1), false},
+		{"a{101}", false},
+		{"a{1,101}", false},
+		{"(a+)+", false},
+		{"(a*)*b", false},
+		{"(a{2}){3}", false},
+		{"(a|aa)+", false},
+		{"(a|b)*", true},
+		{"(ab|cd)*", false},
+		{"(a)\\1", false},
+		{"a(?=b)", false},
+		{"[a-", false},
+	}
+
+	for _, e := range cases {
+		err := checkRegex(e.q)
+		if (err == nil) != e.ok {
+			t.Errorf("%q: got %v want ok %v", e.q, err, e.ok)
+		}
+	}
+}
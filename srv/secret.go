@@ -0,0 +1,176 @@
+package srv
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	SECRET_PREFIX = "enc:"
+)
+
+/**
+* 密钥需用于校验 HMAC 签名，不能单向散列，使用 secret-key 派生的 AES-GCM 加密存储
+**/
+func (s *AppService) secretCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(s.SecretKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+/**
+* 加密密钥，未配置 secret-key 时返回错误，不存储明文
+**/
+func (s *AppService) EncryptSecret(secret string) (string, error) {
+
+	if s.SecretKey == "" {
+		return "", fmt.Errorf("not found config secret-key")
+	}
+
+	gcm, err := s.secretCipher()
+
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	_, err = io.ReadFull(rand.Reader, nonce)
+
+	if err != nil {
+		return "", err
+	}
+
+	return SECRET_PREFIX + base64.RawStdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(secret), nil)), nil
+}
+
+/**
+* 解密密钥，兼容未加密的历史数据
+**/
+func (s *AppService) DecryptSecret(v string) (string, error) {
+
+	if !strings.HasPrefix(v, SECRET_PREFIX) {
+		return v, nil
+	}
+
+	if s.SecretKey == "" {
+		return "", fmt.Errorf("not found config secret-key")
+	}
+
+	b, err := base64.RawStdEncoding.DecodeString(v[len(SECRET_PREFIX):])
+
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := s.secretCipher()
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid secret")
+	}
+
+	r, err := gcm.Open(nil, b[:gcm.NonceSize()], b[gcm.NonceSize():], nil)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(r), nil
+}
+
+/**
+* 文档中有效的密钥，轮换后旧密钥在 prev_expires 前仍然有效
+**/
+func (s *AppService) Secrets(rs bson.M) []string {
+
+	vs := []string{}
+
+	secret, err := s.DecryptSecret(dynamic.StringValue(rs["secret"], ""))
+
+	if err == nil && secret != "" {
+		vs = append(vs, secret)
+	}
+
+	if dynamic.IntValue(rs["prev_expires"], 0) > time.Now().Unix() {
+		secret, err = s.DecryptSecret(dynamic.StringValue(rs["prev_secret"], ""))
+		if err == nil && secret != "" {
+			vs = append(vs, secret)
+		}
+	}
+
+	return vs
+}
+
+/**
+* 轮换密钥时保留旧密钥，返回的 rev 用于条件更新，before 读取后被修改时更新失败，避免保留错误的旧密钥
+**/
+func (s *AppService) rotateSecret(set bson.D, before bson.M, rev int32) (bson.D, int32) {
+	if before == nil {
+		return set, rev
+	}
+	if s.SecretGrace > 0 && before["secret"] != nil {
+		set = append(set, bson.E{"prev_secret", before["secret"]}, bson.E{"prev_expires", time.Now().Unix() + s.SecretGrace})
+	}
+	if rev == 0 {
+		rev = int32(dynamic.IntValue(before["rev"], 0))
+	}
+	return set, rev
+}
+
+/**
+* 加密历史明文密钥
+**/
+func encryptSecrets(c context.Context, app *AppService) error {
+
+	for _, name := range []string{"app", "container", "user"} {
+
+		coll := app.Store.Collection(name)
+
+		items, err := coll.Find(c, bson.D{bson.E{"secret", bson.D{bson.E{"$exists", true}}}}, nil, 0)
+
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+
+			secret, ok := item["secret"].(string)
+
+			if !ok || strings.HasPrefix(secret, SECRET_PREFIX) {
+				continue
+			}
+
+			enc, err := app.EncryptSecret(secret)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = coll.Update(c, bson.D{bson.E{"_id", item["_id"]}, bson.E{"secret", secret}}, &Update{Set: bson.D{bson.E{"secret", enc}}, KeepRev: true})
+
+			if err != nil && err != ErrNotFound {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
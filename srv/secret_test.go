@@ -0,0 +1,88 @@
+package srv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestSecretKeyRequired(t *testing.T) {
+
+	s := newAppService(SERVICE_APP, map[string]interface{}{"storage": STORAGE_MEMORY})
+
+	ctx := &testContext{values: map[string]string{}, services: map[string]micro.Service{SERVICE_APP: s}}
+
+	if s.OnInit(ctx) == nil {
+		t.Fatalf("OnInit without secret-key must fail")
+	}
+
+	_, err := s.EncryptSecret("abc")
+
+	if err == nil {
+		t.Fatalf("EncryptSecret without secret-key must fail")
+	}
+}
+
+func TestSecretRotate(t *testing.T) {
+
+	app := newTestApp()
+	app.SecretGrace = 60
+	c := newTestContext(app)
+	s := &server{}
+
+	rs, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Secret == "" {
+		t.Fatalf("AppCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	appid := rs.Data.Id
+	secret := rs.Data.Secret
+
+	doc, _ := app.Store.App().Get(c, appid)
+
+	if enc, _ := doc["secret"].(string); !strings.HasPrefix(enc, SECRET_PREFIX) {
+		t.Fatalf("secret stored in plaintext %v", doc["secret"])
+	}
+
+	rs, _ = s.AppSet(c, &pb.AppSetTask{Appid: appid, Secret: true})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Secret == "" || rs.Data.Secret == secret {
+		t.Fatalf("AppSet secret %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	doc, _ = app.Store.App().Get(c, appid)
+
+	vs := app.Secrets(doc)
+
+	if len(vs) != 2 || vs[0] != rs.Data.Secret || vs[1] != secret {
+		t.Fatalf("rotated secrets %v", vs)
+	}
+
+	/* 读取 before 后文档被修改时轮换失败 */
+
+	set, rev := app.rotateSecret(bson.D{}, doc, 0)
+
+	if rev == 0 || len(set) != 2 {
+		t.Fatalf("rotateSecret %v %d", set, rev)
+	}
+
+	s.AppSet(c, &pb.AppSetTask{Appid: appid, Title: "x"})
+
+	_, err := app.Store.App().Update(c, appid, &Update{Set: set, Rev: rev})
+
+	if err != ErrConflict {
+		t.Fatalf("rotate after concurrent update %v", err)
+	}
+
+	/* 非 upsert 时不存在的应用不生成密钥 */
+
+	rs, _ = s.AppSet(c, &pb.AppSetTask{Appid: "none", Title: "x"})
+
+	if rs.Errno != ERRNO_NOT_FOUND || rs.Data != nil {
+		t.Fatalf("AppSet not found %d %v", rs.Errno, rs.Data)
+	}
+}
@@ -0,0 +1,450 @@
+package srv
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	SEMVER_MAX = 9999999999 // 数字的最大值，Key 中按 10 位定宽编码
+)
+
+/**
+* 语义化版本 MAJOR.MINOR.PATCH[-PRE][+BUILD]
+**/
+type Semver struct {
+	Major int64
+	Minor int64
+	Patch int64
+	Pre   []string
+	Build string
+}
+
+func parseSemverNumber(s string) (int64, error) {
+	if s == "" || (len(s) > 1 && s[0] == '0') {
+		return 0, fmt.Errorf("invalid number %s", s)
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid number %s", s)
+		}
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n > SEMVER_MAX {
+		return 0, fmt.Errorf("invalid number %s", s)
+	}
+	return n, nil
+}
+
+func isSemverNumeric(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func validSemverIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+/**
+* 预发布标识，数字标识不允许前导 0 且不超过 SEMVER_MAX
+**/
+func checkSemverPre(pre []string) error {
+	for _, id := range pre {
+		if !validSemverIdent(id) {
+			return fmt.Errorf("invalid semver prerelease %s", strings.Join(pre, "."))
+		}
+		if isSemverNumeric(id) {
+			if _, err := parseSemverNumber(id); err != nil {
+				return fmt.Errorf("invalid semver prerelease %s", strings.Join(pre, "."))
+			}
+		}
+	}
+	return nil
+}
+
+func ParseSemver(s string) (*Semver, error) {
+
+	v := &Semver{}
+
+	if i := strings.Index(s, "+"); i >= 0 {
+		v.Build = s[i+1:]
+		s = s[:i]
+		for _, id := range strings.Split(v.Build, ".") {
+			if !validSemverIdent(id) {
+				return nil, fmt.Errorf("invalid semver build %s", v.Build)
+			}
+		}
+	}
+
+	if i := strings.Index(s, "-"); i >= 0 {
+		v.Pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+		err := checkSemverPre(v.Pre)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vs := strings.Split(s, ".")
+
+	if len(vs) != 3 {
+		return nil, fmt.Errorf("invalid semver %s", s)
+	}
+
+	var err error
+
+	if v.Major, err = parseSemverNumber(vs[0]); err != nil {
+		return nil, err
+	}
+
+	if v.Minor, err = parseSemverNumber(vs[1]); err != nil {
+		return nil, err
+	}
+
+	if v.Patch, err = parseSemverNumber(vs[2]); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+/**
+* 可按字节序排序的键，与语义化版本优先级一致，仅用于 ParseSemver 解析的版本(数字不超过 SEMVER_MAX)
+* 正式版以 ~ 结尾，排在同号预发布版之后；数字标识排在字母标识之前
+**/
+func (v *Semver) Key() string {
+
+	b := strings.Builder{}
+
+	b.WriteString(fmt.Sprintf("%010d.%010d.%010d", v.Major, v.Minor, v.Patch))
+
+	if len(v.Pre) == 0 {
+		b.WriteString("~")
+		return b.String()
+	}
+
+	for _, id := range v.Pre {
+		b.WriteString(" ")
+		if isSemverNumeric(id) {
+			n, _ := strconv.ParseInt(id, 10, 64)
+			b.WriteString(fmt.Sprintf("0%010d", n))
+		} else {
+			b.WriteString("1")
+			b.WriteString(id)
+		}
+	}
+
+	return b.String()
+}
+
+func compareInt64(a int64, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+/**
+* 按语义化版本优先级比较，范围上界(如 ^9999999999.0.0 的 <10000000000.0.0-0)可超过 SEMVER_MAX，不使用 Key 比较
+**/
+func (v *Semver) Compare(o *Semver) int {
+
+	for _, r := range []int{compareInt64(v.Major, o.Major), compareInt64(v.Minor, o.Minor), compareInt64(v.Patch, o.Patch)} {
+		if r != 0 {
+			return r
+		}
+	}
+
+	// 正式版高于同号预发布版
+	if len(v.Pre) == 0 || len(o.Pre) == 0 {
+		return compareInt64(int64(len(o.Pre)), int64(len(v.Pre)))
+	}
+
+	for i := 0; i < len(v.Pre) && i < len(o.Pre); i++ {
+
+		a, b := v.Pre[i], o.Pre[i]
+
+		an, bn := isSemverNumeric(a), isSemverNumeric(b)
+
+		switch {
+		case an && bn:
+			x, _ := strconv.ParseInt(a, 10, 64)
+			y, _ := strconv.ParseInt(b, 10, 64)
+			if r := compareInt64(x, y); r != 0 {
+				return r
+			}
+		case an:
+			return -1
+		case bn:
+			return 1
+		default:
+			if r := strings.Compare(a, b); r != 0 {
+				return r
+			}
+		}
+	}
+
+	return compareInt64(int64(len(v.Pre)), int64(len(o.Pre)))
+}
+
+func (v *Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Pre) > 0 {
+		s = s + "-" + strings.Join(v.Pre, ".")
+	}
+	if v.Build != "" {
+		s = s + "+" + v.Build
+	}
+	return s
+}
+
+type semverComparator struct {
+	op       string
+	v        *Semver
+	implicit bool
+}
+
+func (c *semverComparator) match(v *Semver) bool {
+	r := v.Compare(c.v)
+	switch c.op {
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	}
+	return r == 0
+}
+
+/**
+* 版本范围，|| 分隔的多组条件满足任一组即可，组内空格分隔的条件需全部满足
+* 支持 1.2.3、1.2、1.x、*、^1.4、~2.0.3、>=1.2.0 <2.0.0
+**/
+type SemverRange [][]*semverComparator
+
+func parseSemverPartial(s string) ([]int64, []string, error) {
+
+	var pre []string = nil
+
+	if i := strings.Index(s, "+"); i >= 0 {
+		s = s[:i]
+	}
+
+	if i := strings.Index(s, "-"); i >= 0 {
+		pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+		err := checkSemverPre(pre)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	vs := strings.Split(s, ".")
+
+	if len(vs) > 3 {
+		return nil, nil, fmt.Errorf("invalid semver range %s", s)
+	}
+
+	ns := []int64{-1, -1, -1}
+
+	for i, p := range vs {
+		if p == "x" || p == "X" || p == "*" {
+			break
+		}
+		n, err := parseSemverNumber(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		ns[i] = n
+	}
+
+	if pre != nil && ns[2] < 0 {
+		return nil, nil, fmt.Errorf("invalid semver range %s", s)
+	}
+
+	return ns, pre, nil
+}
+
+func semverLower(ns []int64, pre []string) *Semver {
+	v := &Semver{Pre: pre}
+	if ns[0] >= 0 {
+		v.Major = ns[0]
+	}
+	if ns[1] >= 0 {
+		v.Minor = ns[1]
+	}
+	if ns[2] >= 0 {
+		v.Patch = ns[2]
+	}
+	return v
+}
+
+func semverUpper(major int64, minor int64, patch int64) *semverComparator {
+	return &semverComparator{op: "<", v: &Semver{Major: major, Minor: minor, Patch: patch, Pre: []string{"0"}}, implicit: true}
+}
+
+func parseSemverComparator(s string) ([]*semverComparator, error) {
+
+	op := ""
+
+	for _, p := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(s, p) {
+			op = p
+			s = s[len(p):]
+			break
+		}
+	}
+
+	if s == "" && op == "" {
+		s = "*"
+	}
+
+	ns, pre, err := parseSemverPartial(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lower := &semverComparator{op: ">=", v: semverLower(ns, pre)}
+
+	switch op {
+	case "^":
+		if ns[0] < 0 {
+			return []*semverComparator{lower}, nil
+		}
+		if ns[0] > 0 || ns[1] < 0 {
+			return []*semverComparator{lower, semverUpper(ns[0]+1, 0, 0)}, nil
+		}
+		if ns[1] > 0 || ns[2] < 0 {
+			return []*semverComparator{lower, semverUpper(0, ns[1]+1, 0)}, nil
+		}
+		return []*semverComparator{lower, semverUpper(0, 0, ns[2]+1)}, nil
+	case "~":
+		if ns[0] < 0 {
+			return []*semverComparator{lower}, nil
+		}
+		if ns[1] < 0 {
+			return []*semverComparator{lower, semverUpper(ns[0]+1, 0, 0)}, nil
+		}
+		return []*semverComparator{lower, semverUpper(ns[0], ns[1]+1, 0)}, nil
+	case ">":
+		if ns[0] < 0 {
+			return []*semverComparator{{op: "<", v: &Semver{Pre: []string{"0"}}, implicit: true}}, nil
+		}
+		if ns[1] < 0 {
+			return []*semverComparator{{op: ">=", v: &Semver{Major: ns[0] + 1}}}, nil
+		}
+		if ns[2] < 0 {
+			return []*semverComparator{{op: ">=", v: &Semver{Major: ns[0], Minor: ns[1] + 1}}}, nil
+		}
+		return []*semverComparator{{op: ">", v: semverLower(ns, pre)}}, nil
+	case ">=":
+		return []*semverComparator{lower}, nil
+	case "<":
+		return []*semverComparator{{op: "<", v: semverLower(ns, pre)}}, nil
+	case "<=":
+		if ns[0] < 0 {
+			return []*semverComparator{{op: ">=", v: &Semver{}}}, nil
+		}
+		if ns[1] < 0 {
+			return []*semverComparator{semverUpper(ns[0]+1, 0, 0)}, nil
+		}
+		if ns[2] < 0 {
+			return []*semverComparator{semverUpper(ns[0], ns[1]+1, 0)}, nil
+		}
+		return []*semverComparator{{op: "<=", v: semverLower(ns, pre)}}, nil
+	}
+
+	if ns[0] < 0 {
+		return []*semverComparator{{op: ">=", v: &Semver{}}}, nil
+	}
+
+	if ns[1] < 0 {
+		return []*semverComparator{lower, semverUpper(ns[0]+1, 0, 0)}, nil
+	}
+
+	if ns[2] < 0 {
+		return []*semverComparator{lower, semverUpper(ns[0], ns[1]+1, 0)}, nil
+	}
+
+	return []*semverComparator{{op: "=", v: semverLower(ns, pre)}}, nil
+}
+
+func ParseSemverRange(s string) (SemverRange, error) {
+
+	r := SemverRange{}
+
+	for _, item := range strings.Split(s, "||") {
+
+		set := []*semverComparator{}
+
+		for _, p := range strings.Fields(item) {
+			cs, err := parseSemverComparator(p)
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, cs...)
+		}
+
+		if len(set) == 0 {
+			set = append(set, &semverComparator{op: ">=", v: &Semver{}})
+		}
+
+		r = append(r, set)
+	}
+
+	return r, nil
+}
+
+/**
+* 预发布版本仅在同组条件中显式包含同号预发布版本时匹配
+**/
+func (r SemverRange) Match(v *Semver) bool {
+
+	for _, set := range r {
+
+		ok := true
+
+		for _, c := range set {
+			if !c.match(v) {
+				ok = false
+				break
+			}
+		}
+
+		if !ok {
+			continue
+		}
+
+		if len(v.Pre) == 0 {
+			return true
+		}
+
+		for _, c := range set {
+			if !c.implicit && len(c.v.Pre) > 0 && c.v.Major == v.Major && c.v.Minor == v.Minor && c.v.Patch == v.Patch {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package srv
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestParseSemver(t *testing.T) {
+
+	cases := []struct {
+		s  string
+		ok bool
+	}{
+		{"1.2.3", true},
+		{"0.0.0", true},
+		{"1.2.3-alpha.1+build.5", true},
+		{"9999999999.9999999999.9999999999", true},
+		{"1.0.0-9999999999", true},
+		{"10000000000.0.0", false},
+		{"1.10000000000.0", false},
+		{"1.0.99999999999999999999", false},
+		{"1.0.0-10000000000", false},
+		{"1.0.0-alpha.10000000000", false},
+		{"1.0.0-01", false},
+		{"01.0.0", false},
+		{"1.0", false},
+		{"1.0.0-", false},
+		{"1.0.0-a_b", false},
+		{"1.0.0+", false},
+		{"v1.0.0", false},
+	}
+
+	for _, e := range cases {
+		_, err := ParseSemver(e.s)
+		if (err == nil) != e.ok {
+			t.Errorf("%s: got %v want ok %v", e.s, err, e.ok)
+		}
+	}
+}
+
+/**
+* Key 的字节序与 Compare 一致
+**/
+func TestSemverKeyOrder(t *testing.T) {
+
+	vs := []string{
+		"1.0.0-1",
+		"1.0.0-2",
+		"1.0.0-10",
+		"1.0.0-9999999999",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-alpha-x",
+		"1.0.0-beta",
+		"1.0.0",
+		"1.0.1",
+		"1.2.0",
+		"1.10.0",
+		"2.0.0",
+		"10.0.0",
+		"9999999999.0.0",
+		"9999999999.9999999999.9999999999",
+	}
+
+	items := []*Semver{}
+
+	for _, s := range vs {
+		v, err := ParseSemver(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, v)
+	}
+
+	for i := 1; i < len(items); i++ {
+		if items[i-1].Compare(items[i]) >= 0 {
+			t.Errorf("Compare %s >= %s", vs[i-1], vs[i])
+		}
+		if items[i-1].Key() >= items[i].Key() {
+			t.Errorf("Key %s >= %s", vs[i-1], vs[i])
+		}
+	}
+
+	keys := []string{}
+
+	for i := len(items) - 1; i >= 0; i-- {
+		keys = append(keys, items[i].Key())
+	}
+
+	sort.Strings(keys)
+
+	for i, key := range keys {
+		if key != items[i].Key() {
+			t.Fatalf("sorted keys %s", strings.Join(keys, ","))
+		}
+	}
+}
+
+func TestSemverRange(t *testing.T) {
+
+	cases := []struct {
+		r  string
+		v  string
+		ok bool
+	}{
+		{"^1.2.0", "1.9.0", true},
+		{"^1.2.0", "2.0.0", false},
+		{"^1.2.0", "2.0.0-alpha", false},
+		{"~1.2.0", "1.2.9", true},
+		{"~1.2.0", "1.3.0", false},
+		{"1.x", "1.5.0", true},
+		{">=1.0.0-alpha <1.0.0", "1.0.0-beta", true},
+		{"^1.0.0", "1.1.0-beta", false},
+		{"^9999999999.0.0", "9999999999.1.0", true},
+		{"~9999999999.9999999999.0", "9999999999.9999999999.1", true},
+		{"<=9999999999", "9999999999.9999999999.9999999999", true},
+		{"1.0.0 || 2.x", "2.3.0", true},
+	}
+
+	for _, e := range cases {
+
+		r, err := ParseSemverRange(e.r)
+
+		if err != nil {
+			t.Fatalf("%s: %v", e.r, err)
+		}
+
+		v, err := ParseSemver(e.v)
+
+		if err != nil {
+			t.Fatalf("%s: %v", e.v, err)
+		}
+
+		if r.Match(v) != e.ok {
+			t.Errorf("%s match %s: want %v", e.r, e.v, e.ok)
+		}
+	}
+
+	for _, s := range []string{"^10000000000", "1.0.0-10000000000", "1.2.3.4"} {
+		_, err := ParseSemverRange(s)
+		if err == nil {
+			t.Errorf("%s: invalid range must fail", s)
+		}
+	}
+}
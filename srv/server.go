@@ -3,7 +3,6 @@ package srv
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -11,11 +10,8 @@ import (
 	"github.com/ability-sh/abi-lib/json"
 	"github.com/ability-sh/abi-micro-app/pb"
 	"github.com/ability-sh/abi-micro/grpc"
-	"github.com/ability-sh/abi-micro/mongodb"
 	"github.com/ability-sh/abi-micro/oss"
 	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"
 	G "google.golang.org/grpc"
 )
 
@@ -42,7 +38,7 @@ func decodeObject(s string) interface{} {
 func setApp(a *pb.App, rs bson.M) {
 	a.Id = dynamic.StringValue(rs["_id"], "")
 	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
-	a.Secret = dynamic.StringValue(rs["secret"], "")
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
 	a.Title = dynamic.StringValue(rs["title"], "")
 	a.Info = encodeObject(rs["info"])
 }
@@ -61,9 +57,12 @@ func setVer(a *pb.Ver, rs bson.M) {
 	a.Appid = dynamic.StringValue(rs["appid"], "")
 	a.Ver = dynamic.StringValue(rs["ver"], "")
 	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
 	a.Title = dynamic.StringValue(rs["title"], "")
 	a.Info = encodeObject(rs["info"])
-	a.Status = int32(dynamic.IntValue(rs["status"], 0))
+	a.Status = pb.VerStatus(dynamic.IntValue(rs["status"], 0))
+	a.Transitions = toVerTransitions(rs["transitions"])
+	setPackages(a, rs)
 }
 
 func toVerItems(rs []bson.M) []*pb.Ver {
@@ -79,7 +78,7 @@ func toVerItems(rs []bson.M) []*pb.Ver {
 func setContainer(a *pb.Container, rs bson.M) {
 	a.Id = dynamic.StringValue(rs["_id"], "")
 	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
-	a.Secret = dynamic.StringValue(rs["secret"], "")
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
 	a.Title = dynamic.StringValue(rs["title"], "")
 	a.Info = encodeObject(rs["info"])
 	a.Env = map[string]string{}
@@ -103,7 +102,10 @@ func setAc(a *pb.Ac, rs bson.M) {
 	a.Cid = dynamic.StringValue(rs["cid"], "")
 	a.Appid = dynamic.StringValue(rs["appid"], "")
 	a.Ctime = int32(dynamic.IntValue(rs["ctime"], 0))
+	a.Rev = int32(dynamic.IntValue(rs["rev"], 0))
 	a.Ver = dynamic.StringValue(rs["ver"], "")
+	a.VerRange = dynamic.StringValue(rs["range"], "")
+	a.Channel = dynamic.StringValue(rs["channel"], "")
 	a.Title = dynamic.StringValue(rs["title"], "")
 	a.Info = encodeObject(rs["info"])
 	a.Env = map[string]string{}
@@ -134,7 +136,7 @@ func (s *server) AppCreate(c context.Context, task *pb.AppCreateTask) (*pb.AppRe
 	if task.Info != "" {
 		err := json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.AppResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 	}
 
@@ -144,32 +146,39 @@ func (s *server) AppCreate(c context.Context, task *pb.AppCreateTask) (*pb.AppRe
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	id := app.NewID()
+	secret := app.NewSecret()
+	ctime := int32(time.Now().Unix())
+
+	enc, err := app.EncryptSecret(secret)
 
 	if err != nil {
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	db := conn.Database(app.Db)
+	doc := bson.D{bson.E{"_id", id},
+		bson.E{"title", task.Title},
+		bson.E{"info", info},
+		bson.E{"secret", enc},
+		bson.E{"ctime", ctime},
+		bson.E{"rev", 1}}
 
-	db_app := db.Collection("app")
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	id := app.NewID()
-	secret := app.NewSecret()
-	ctime := int32(time.Now().Unix())
+		err := app.Store.App().Create(c, doc)
 
-	_, err = db_app.InsertOne(c,
-		bson.D{bson.E{"_id", id},
-			bson.E{"title", task.Title},
-			bson.E{"info", info},
-			bson.E{"secret", secret},
-			bson.E{"ctime", ctime}})
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "AppCreate", AUDIT_APP, id, nil, doc)
+	})
 
 	if err != nil {
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	a := &pb.App{Id: id, Title: task.Title, Info: task.Info, Secret: secret, Ctime: ctime}
+	a := &pb.App{Id: id, Title: task.Title, Info: task.Info, Secret: secret, Ctime: ctime, Rev: 1}
 
 	return &pb.AppResult{Errno: ERRNO_OK, Data: a}, nil
 }
@@ -190,26 +199,184 @@ func (s *server) AppRemove(c context.Context, task *pb.AppRemoveTask) (*pb.AppRe
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	if task.Cascade {
 
-	if err != nil {
-		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
+		var rs bson.M
+
+		removed := &pb.Removed{}
+
+		var vers []bson.M
+		var acs []bson.M
+		var channels []bson.M
+		var rollouts []bson.M
+
+		err = app.Store.Transaction(c, func(c context.Context) error {
+
+			var err error
+
+			rs, err = app.Store.App().Remove(c, task.Appid, task.ExpectedRev)
+
+			if err != nil {
+				return err
+			}
+
+			vers, err = removeMany(c, app, "ver", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			acs, err = removeMany(c, app, "ac", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = removeMany(c, app, "ac_history", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			channels, err = removeMany(c, app, "channel", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = removeMany(c, app, "channel_history", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			rollouts, err = removeMany(c, app, "rollout", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			items, err := removeMany(c, app, "rollout_item", bson.D{bson.E{"appid", task.Appid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = app.Store.Collection("rollout_active").RemoveMany(c, bson.D{bson.E{"_id", task.Appid}})
+
+			if err != nil {
+				return err
+			}
+
+			removed.Ver = int32(len(vers))
+			removed.Ac = int32(len(acs))
+			removed.Channel = int32(len(channels))
+			removed.Rollout = int32(len(rollouts))
+			removed.RolloutItem = int32(len(items))
+
+			err = audit(c, ctx, app, "AppRemove", AUDIT_APP, task.Appid, rs, nil)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range vers {
+				err = audit(c, ctx, app, "AppRemove", AUDIT_VER, task.Appid+"/"+dynamic.StringValue(v["ver"], ""), v, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, v := range acs {
+				err = audit(c, ctx, app, "AppRemove", AUDIT_AC, dynamic.StringValue(v["cid"], "")+"/"+task.Appid, v, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, v := range channels {
+				err = audit(c, ctx, app, "AppRemove", AUDIT_CHANNEL, task.Appid+"/"+dynamic.StringValue(v["name"], ""), v, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, v := range rollouts {
+				err = audit(c, ctx, app, "AppRemove", AUDIT_ROLLOUT, dynamic.StringValue(v["_id"], ""), v, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			errno, errmsg := storeErrno(err, "app")
+			return &pb.AppResult{Errno: errno, Errmsg: errmsg}, nil
+		}
+
+		keys := []string{}
+
+		for _, v := range vers {
+			keys = append(keys, packageKeys(app, task.Appid, v, task.Ability)...)
+		}
+
+		/* 数据已删除，包文件尽力删除，失败的文件记入 removed.ossFailed */
+
+		if len(keys) > 0 {
+
+			ss, err := oss.GetOSS(ctx, SERVICE_OSS)
+
+			if err != nil {
+				ctx.Println("AppRemove", "oss", err)
+				removed.OssFailed = keys
+			} else {
+
+				for _, key := range keys {
+
+					has, err := ss.Has(key)
+
+					if err == nil && has {
+						err = ss.Del(key)
+						if err == nil {
+							removed.Oss = removed.Oss + 1
+						}
+					}
 
-	db := conn.Database(app.Db)
+					if err != nil {
+						ctx.Println("AppRemove", "oss", key, err)
+						removed.OssFailed = append(removed.OssFailed, key)
+					}
+				}
+			}
+		}
+
+		a := &pb.App{}
+
+		setApp(a, rs)
 
-	db_app := db.Collection("app")
+		return &pb.AppResult{Errno: ERRNO_OK, Data: a, Removed: removed}, nil
+	}
 
 	var rs bson.M
 
-	err = db_app.FindOneAndDelete(c,
-		bson.D{bson.E{"_id", task.Appid}}).Decode(&rs)
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.AppResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
+		var err error
+
+		rs, err = app.Store.App().Remove(c, task.Appid, task.ExpectedRev)
+
+		if err != nil {
+			return err
 		}
-		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+
+		return audit(c, ctx, app, "AppRemove", AUDIT_APP, task.Appid, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "app")
+		return &pb.AppResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	a := &pb.App{}
@@ -235,16 +402,6 @@ func (s *server) AppSet(c context.Context, task *pb.AppSetTask) (*pb.AppResult,
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_app := db.Collection("app")
-
 	set := bson.D{}
 
 	if task.Title != "" {
@@ -255,7 +412,11 @@ func (s *server) AppSet(c context.Context, task *pb.AppSetTask) (*pb.AppResult,
 
 	if task.Secret {
 		secret = app.NewSecret()
-		set = append(set, bson.E{"secret", secret})
+		enc, err := app.EncryptSecret(secret)
+		if err != nil {
+			return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+		set = append(set, bson.E{"secret", enc})
 	}
 
 	var info interface{} = nil
@@ -263,7 +424,7 @@ func (s *server) AppSet(c context.Context, task *pb.AppSetTask) (*pb.AppResult,
 	if task.Info != "" {
 		err = json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.AppResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 		dynamic.Each(info, func(key interface{}, value interface{}) bool {
 			set = append(set, bson.E{fmt.Sprintf("info.%s", dynamic.StringValue(key, "")), value})
@@ -275,58 +436,67 @@ func (s *server) AppSet(c context.Context, task *pb.AppSetTask) (*pb.AppResult,
 
 	if len(set) == 0 {
 
-		var rs bson.M
+		rs, err := app.Store.App().Get(c, task.Appid)
 
-		err = db_app.FindOne(c,
-			bson.D{bson.E{"_id", task.Appid}}).Decode(&rs)
+		if err == nil && task.ExpectedRev > 0 && int32(dynamic.IntValue(rs["rev"], 0)) != task.ExpectedRev {
+			err = ErrConflict
+		}
 
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.AppResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-			}
-			return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			errno, errmsg := storeErrno(err, "app")
+			return &pb.AppResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setApp(a, rs)
 
 	} else {
 
-		opts := options.FindOneAndUpdate().SetUpsert(true)
+		setOnInsert := bson.D{bson.E{"ctime", int32(time.Now().Unix())}}
+
+		if task.Upsert && !task.Secret {
+			secret = app.NewSecret()
+			enc, err := app.EncryptSecret(secret)
+			if err != nil {
+				return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			}
+			setOnInsert = append(setOnInsert, bson.E{"secret", enc})
+		}
 
+		var before bson.M
 		var rs bson.M
 
-		err = db_app.FindOneAndUpdate(c,
-			bson.D{bson.E{"_id", task.Appid}}, bson.D{bson.E{"$set", set}}, opts).Decode(&rs)
+		err = app.Store.Transaction(c, func(c context.Context) error {
 
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.AppResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
+			var err error
+
+			before = findDoc(c, app.Store.Collection("app"), bson.D{bson.E{"_id", task.Appid}})
+
+			update, rev := set, task.ExpectedRev
+
+			if task.Secret {
+				update, rev = app.rotateSecret(set, before, rev)
 			}
-			return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-		}
 
-		{
-			var i = rs["info"]
-			if i == nil {
-				i = map[string]interface{}{}
-				rs["info"] = i
+			rs, err = app.Store.App().Update(c, task.Appid, &Update{Set: update, SetOnInsert: setOnInsert, Rev: rev, Upsert: task.Upsert})
+
+			if err != nil {
+				return err
 			}
-			dynamic.Each(info, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
-		}
 
-		if task.Title != "" {
-			rs["title"] = task.Title
-		}
+			return audit(c, ctx, app, "AppSet", AUDIT_APP, task.Appid, before, rs)
+		})
 
-		if task.Secret {
-			rs["title"] = secret
+		if err != nil {
+			errno, errmsg := storeErrno(err, "app")
+			return &pb.AppResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setApp(a, rs)
 
+		if task.Secret || before == nil {
+			a.Secret = secret
+		}
+
 	}
 
 	return &pb.AppResult{Errno: ERRNO_OK, Data: a}, nil
@@ -348,28 +518,13 @@ func (s *server) AppGet(c context.Context, task *pb.AppGetTask) (*pb.AppResult,
 		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_app := db.Collection("app")
-
 	a := &pb.App{}
 
-	var rs bson.M
-
-	err = db_app.FindOne(c,
-		bson.D{bson.E{"_id", task.Appid}}).Decode(&rs)
+	rs, err := app.Store.App().Get(c, task.Appid)
 
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.AppResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-		}
-		return &pb.AppResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "app")
+		return &pb.AppResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	setApp(a, rs)
@@ -389,73 +544,29 @@ func (s *server) AppQuery(c context.Context, task *pb.AppQueryTask) (*pb.AppQuer
 		return &pb.AppQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.AppQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	n := task.N
-	p := task.P
-
-	if n < 1 {
-		n = 20
-	}
-
-	rs := &pb.AppQueryResult{}
-
-	db := conn.Database(app.Db)
-
-	db_app := db.Collection("app")
-
-	opts := options.Find().SetSort(bson.D{bson.E{"ctime", -1}}).SetLimit(int64(n))
-
-	filter := bson.D{}
-
-	if task.Q != "" {
-		filter = append(filter, bson.E{"title", bson.E{"$regex", task.Q}})
-	}
-
-	if p > 0 {
-
-		opts = opts.SetSkip(int64(n * (p - 1)))
+	q := &Query{Where: bson.D{},
+		Q:          task.Q,
+		Match:      task.Match,
+		IgnoreCase: task.IgnoreCase,
+		Filter:     task.Filter,
+		P:          task.P,
+		N:          task.N,
+		Cursor:     task.Cursor,
+		Count:      task.Count}
 
-		totalCount, err := db_app.CountDocuments(c, filter)
-
-		if err != nil {
-			return &pb.AppQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-		}
-
-		count := int32(totalCount) / n
-
-		if int32(totalCount)%n != 0 {
-			count = count + 1
-		}
-
-		rs.Page = &pb.Page{P: p, N: n, TotalCount: int32(totalCount), Count: count}
-
-	}
-
-	cursor, err := db_app.Find(c, filter, opts)
+	err = q.Check()
 
 	if err != nil {
-		return &pb.AppQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AppQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 	}
 
-	defer cursor.Close(c)
-
-	var items []bson.M
-
-	err = cursor.All(c, &items)
+	rs, err := app.Store.App().Query(c, q)
 
 	if err != nil {
 		return &pb.AppQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	rs.Items = toAppItems(items)
-	rs.Errno = ERRNO_OK
-
-	return rs, nil
+	return &pb.AppQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toAppItems(rs.Items), Cursor: rs.Cursor}, nil
 }
 
 func (s *server) VerCreate(c context.Context, task *pb.VerCreateTask) (*pb.VerResult, error) {
@@ -472,12 +583,22 @@ func (s *server) VerCreate(c context.Context, task *pb.VerCreateTask) (*pb.VerRe
 		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param ver"}, nil
 	}
 
+	v, err := ParseSemver(task.Ver)
+
+	if err != nil {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+	}
+
+	if !verStatusInitial[task.Status] {
+		return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: fmt.Sprintf("invalid ver status %s", task.Status)}, nil
+	}
+
 	var info interface{} = nil
 
 	if task.Info != "" {
 		err := json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.VerResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 	}
 
@@ -487,31 +608,64 @@ func (s *server) VerCreate(c context.Context, task *pb.VerCreateTask) (*pb.VerRe
 		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	errno, err := checkApp(c, app, task.Appid)
 
 	if err != nil {
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.VerResult{Errno: errno, Errmsg: err.Error()}, nil
 	}
 
-	db := conn.Database(app.Db)
+	ctime := int32(time.Now().Unix())
 
-	db_ver := db.Collection("ver")
+	transitions := bson.A{}
 
-	ctime := int32(time.Now().Unix())
+	if task.Status != pb.VerStatus_VER_DRAFT {
+		transitions = append(transitions, verTransitionDoc(c, pb.VerStatus_VER_DRAFT, task.Status))
+	}
+
+	doc := bson.D{bson.E{"title", task.Title},
+		bson.E{"info", info},
+		bson.E{"appid", task.Appid},
+		bson.E{"ver", task.Ver},
+		bson.E{"vkey", v.Key()},
+		bson.E{"status", int32(task.Status)},
+		bson.E{"transitions", transitions},
+		bson.E{"packages", bson.D{}},
+		bson.E{"ctime", ctime},
+		bson.E{"rev", 1}}
+
+	err = app.Store.Transaction(c, func(c context.Context) error {
+
+		err := app.Store.Ver().Create(c, doc)
+
+		if err != nil {
+			return err
+		}
+
+		return audit(c, ctx, app, "VerCreate", AUDIT_VER, task.Appid+"/"+task.Ver, nil, doc)
+	})
+
+	if err == ErrDuplicate && task.IfNotExists {
+
+		rs, err := app.Store.Ver().Get(c, task.Appid, task.Ver)
+
+		if err != nil {
+			errno, errmsg := storeErrno(err, "ver")
+			return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
+		}
 
-	_, err = db_ver.InsertOne(c,
-		bson.D{bson.E{"title", task.Title},
-			bson.E{"info", info},
-			bson.E{"appid", task.Appid},
-			bson.E{"ver", task.Ver},
-			bson.E{"status", task.Status},
-			bson.E{"ctime", ctime}})
+		a := &pb.Ver{}
+
+		setVer(a, rs)
+
+		return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
+	}
 
 	if err != nil {
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "ver")
+		return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
-	a := &pb.Ver{Title: task.Title, Info: task.Info, Appid: task.Appid, Ver: task.Ver, Ctime: ctime}
+	a := &pb.Ver{Title: task.Title, Info: task.Info, Appid: task.Appid, Ver: task.Ver, Status: task.Status, Transitions: toVerTransitions(transitions), Ctime: ctime, Rev: 1}
 
 	return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
 }
@@ -536,26 +690,34 @@ func (s *server) VerRemove(c context.Context, task *pb.VerRemoveTask) (*pb.VerRe
 		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	ok, err := exists(c, app.Store.Collection("channel"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}})
 
 	if err != nil {
 		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	db := conn.Database(app.Db)
-
-	db_ver := db.Collection("ver")
+	if ok {
+		return &pb.VerResult{Errno: ERRNO_CONFLICT, Errmsg: "ver in use by channel"}, nil
+	}
 
 	var rs bson.M
 
-	err = db_ver.FindOneAndDelete(c,
-		bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}}).Decode(&rs)
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
+		var err error
+
+		rs, err = app.Store.Ver().Remove(c, task.Appid, task.Ver, task.ExpectedRev)
+
+		if err != nil {
+			return err
 		}
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+
+		return audit(c, ctx, app, "VerRemove", AUDIT_VER, task.Appid+"/"+task.Ver, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ver")
+		return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	a := &pb.Ver{}
@@ -587,25 +749,20 @@ func (s *server) VerSet(c context.Context, task *pb.VerSetTask) (*pb.VerResult,
 		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_ver := db.Collection("ver")
-
 	set := bson.D{}
 
 	if task.Title != "" {
 		set = append(set, bson.E{"title", task.Title})
 	}
 
+	status := pb.VerStatus_VER_DRAFT
+
 	if task.Status != "" {
-		s, _ := strconv.Atoi(task.Status)
-		set = append(set, bson.E{"status", s})
+		status, err = parseVerStatus(task.Status)
+		if err != nil {
+			return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+		}
+		set = append(set, bson.E{"status", int32(status)})
 	}
 
 	var info interface{} = nil
@@ -613,7 +770,7 @@ func (s *server) VerSet(c context.Context, task *pb.VerSetTask) (*pb.VerResult,
 	if task.Info != "" {
 		err = json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.VerResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 		dynamic.Each(info, func(key interface{}, value interface{}) bool {
 			set = append(set, bson.E{fmt.Sprintf("info.%s", dynamic.StringValue(key, "")), value})
@@ -625,58 +782,103 @@ func (s *server) VerSet(c context.Context, task *pb.VerSetTask) (*pb.VerResult,
 
 	if len(set) == 0 {
 
-		var rs bson.M
+		rs, err := app.Store.Ver().Get(c, task.Appid, task.Ver)
 
-		err = db_ver.FindOne(c,
-			bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}}).Decode(&rs)
+		if err == nil && task.ExpectedRev > 0 && int32(dynamic.IntValue(rs["rev"], 0)) != task.ExpectedRev {
+			err = ErrConflict
+		}
 
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-			}
-			return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			errno, errmsg := storeErrno(err, "ver")
+			return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setVer(a, rs)
 
 	} else {
 
-		opts := options.FindOneAndUpdate().SetUpsert(true)
+		if task.Upsert {
 
-		var rs bson.M
+			errno, err := checkApp(c, app, task.Appid)
 
-		err = db_ver.FindOneAndUpdate(c,
-			bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}}, bson.D{bson.E{"$set", set}}, opts).Decode(&rs)
+			if err != nil {
+				return &pb.VerResult{Errno: errno, Errmsg: err.Error()}, nil
+			}
+		}
 
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ver"}, nil
+		setOnInsert := bson.D{bson.E{"ctime", int32(time.Now().Unix())}}
+
+		before := findDoc(c, app.Store.Collection("ver"), bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}})
+
+		if task.Upsert && before == nil {
+
+			v, err := ParseSemver(task.Ver)
+
+			if err != nil {
+				return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 			}
-			return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+
+			setOnInsert = append(setOnInsert, bson.E{"vkey", v.Key()})
 		}
 
-		{
-			var i = rs["info"]
-			if i == nil {
-				i = map[string]interface{}{}
-				rs["info"] = i
+		filter := bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}}
+
+		u := &Update{Set: set, SetOnInsert: setOnInsert, Rev: task.ExpectedRev, Upsert: task.Upsert}
+
+		if task.Status != "" {
+
+			from := pb.VerStatus_VER_DRAFT
+
+			if before != nil {
+				from = pb.VerStatus(dynamic.IntValue(before["status"], 0))
+			} else if !verStatusInitial[status] {
+				return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: fmt.Sprintf("invalid ver status %s", status)}, nil
+			}
+
+			if from != status {
+
+				if before != nil && !canVerTransition(from, status) {
+					return &pb.VerResult{Errno: ERRNO_INPUT_DATA, Errmsg: fmt.Sprintf("ver status %s to %s not allowed", from, status)}, nil
+				}
+
+				if before != nil {
+					filter = append(filter, bson.E{"status", int32(from)})
+				}
+
+				u.Push = bson.D{bson.E{"transitions", verTransitionDoc(c, from, status)}}
 			}
-			dynamic.Each(info, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
 		}
 
-		if task.Title != "" {
-			rs["title"] = task.Title
+		if u.Push == nil {
+			u.SetOnInsert = append(u.SetOnInsert, bson.E{"transitions", bson.A{}})
 		}
 
-		if task.Status != "" {
-			rs["status"], _ = strconv.Atoi(task.Status)
+		u.SetOnInsert = append(u.SetOnInsert, bson.E{"packages", bson.D{}})
+
+		var rs bson.M
+
+		err = app.Store.Transaction(c, func(c context.Context) error {
+
+			var err error
+
+			rs, err = app.Store.Collection("ver").Update(c, filter, u)
+
+			if err != nil {
+				return err
+			}
+
+			return audit(c, ctx, app, "VerSet", AUDIT_VER, task.Appid+"/"+task.Ver, before, rs)
+		})
+
+		if err != nil {
+			errno, errmsg := storeErrno(err, "ver")
+			if (errno == ERRNO_NOT_FOUND || errno == ERRNO_DUPLICATE) && before != nil {
+				errno, errmsg = ERRNO_CONFLICT, "ver status conflict"
+			}
+			return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setVer(a, rs)
-
 	}
 
 	return &pb.VerResult{Errno: ERRNO_OK, Data: a}, nil
@@ -702,28 +904,13 @@ func (s *server) VerGet(c context.Context, task *pb.VerGetTask) (*pb.VerResult,
 		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_ver := db.Collection("ver")
-
 	a := &pb.Ver{}
 
-	var rs bson.M
-
-	err = db_ver.FindOne(c,
-		bson.D{bson.E{"appid", task.Appid}, bson.E{"ver", task.Ver}}).Decode(&rs)
+	rs, err := app.Store.Ver().Get(c, task.Appid, task.Ver)
 
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.VerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-		}
-		return &pb.VerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "ver")
+		return &pb.VerResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	setVer(a, rs)
@@ -746,82 +933,50 @@ func (s *server) VerQuery(c context.Context, task *pb.VerQueryTask) (*pb.VerQuer
 		return &pb.VerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.VerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	n := task.N
-	p := task.P
-
-	if n < 1 {
-		n = 20
-	}
-
-	rs := &pb.VerQueryResult{}
-
-	db := conn.Database(app.Db)
-
-	db_ver := db.Collection("ver")
-
-	opts := options.Find().SetSort(bson.D{bson.E{"ctime", -1}}).SetLimit(int64(n))
-
-	filter := bson.D{bson.E{"appid", task.Appid}}
-
-	if task.Q != "" {
-		filter = append(filter, bson.E{"title", bson.E{"$regex", task.Q}})
-	}
-
 	if task.Status != "" {
-		vs := bson.D{}
+		if task.Filter == nil {
+			task.Filter = &pb.Filter{}
+		}
 		for _, s := range strings.Split(task.Status, ",") {
-			ss, _ := strconv.Atoi(s)
-			vs = append(vs, bson.E{"status", ss})
+			ss, err := parseVerStatus(s)
+			if err != nil {
+				return &pb.VerQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
+			}
+			task.Filter.Statuses = append(task.Filter.Statuses, int32(ss))
 		}
-		filter = append(filter, bson.E{"$or", vs})
 	}
 
-	if p > 0 {
-
-		opts = opts.SetSkip(int64(n * (p - 1)))
-
-		totalCount, err := db_ver.CountDocuments(c, filter)
-
-		if err != nil {
-			return &pb.VerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-		}
-
-		count := int32(totalCount) / n
-
-		if int32(totalCount)%n != 0 {
-			count = count + 1
-		}
-
-		rs.Page = &pb.Page{P: p, N: n, TotalCount: int32(totalCount), Count: count}
+	sort := bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}
 
+	if task.Sort == "semver" {
+		sort = bson.D{bson.E{"vkey", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}}
 	}
 
-	cursor, err := db_ver.Find(c, filter, opts)
+	q := &Query{Where: bson.D{bson.E{"appid", task.Appid}},
+		Q:          task.Q,
+		Match:      task.Match,
+		IgnoreCase: task.IgnoreCase,
+		Filter:     task.Filter,
+		Status:     true,
+		Sort:       sort,
+		P:          task.P,
+		N:          task.N,
+		Cursor:     task.Cursor,
+		Count:      task.Count}
+
+	err = q.Check()
 
 	if err != nil {
-		return &pb.VerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.VerQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 	}
 
-	defer cursor.Close(c)
-
-	var items []bson.M
-
-	err = cursor.All(c, &items)
+	rs, err := app.Store.Ver().Query(c, q)
 
 	if err != nil {
 		return &pb.VerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	rs.Items = toVerItems(items)
-	rs.Errno = ERRNO_OK
-
-	return rs, nil
+	return &pb.VerQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toVerItems(rs.Items), Cursor: rs.Cursor}, nil
 }
 
 func (s *server) VerGetURL(c context.Context, task *pb.VerGetURLTask) (*pb.VerGetURLResult, error) {
@@ -846,10 +1001,16 @@ func (s *server) VerGetURL(c context.Context, task *pb.VerGetURLTask) (*pb.VerGe
 		task.Expires = 300
 	}
 
-	app, err := GetAppService(ctx, SERVICE_APP)
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.VerGetURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	errno, err := checkPackage(c, ctx, app, task.Appid, task.Ver, task.Ability)
 
 	if err != nil {
-		return &pb.VerGetURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.VerGetURLResult{Errno: errno, Errmsg: err.Error()}, nil
 	}
 
 	ss, err := oss.GetOSS(ctx, SERVICE_OSS)
@@ -858,7 +1019,7 @@ func (s *server) VerGetURL(c context.Context, task *pb.VerGetURLTask) (*pb.VerGe
 		return &pb.VerGetURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	u, err := ss.GetSignURL(fmt.Sprintf("%s%s/v%s-%s.zip", app.BasePath, task.Appid, task.Ver, task.Ability), time.Duration(task.Expires)*time.Second)
+	u, err := ss.GetSignURL(app.PackageKey(task.Appid, task.Ver, task.Ability), time.Duration(task.Expires)*time.Second)
 
 	if err != nil {
 		return &pb.VerGetURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
@@ -902,7 +1063,7 @@ func (s *server) VerUpURL(c context.Context, task *pb.VerUpURLTask) (*pb.VerUpUR
 		return &pb.VerUpURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	u, data, err := ss.PostSignURL(fmt.Sprintf("%s%s/v%s-%s.zip", app.BasePath, task.Appid, task.Ver, task.Ability), time.Duration(task.Expires)*time.Second, app.AppMaxSize, nil)
+	u, data, err := ss.PostSignURL(app.PackageKey(task.Appid, task.Ver, task.Ability), time.Duration(task.Expires)*time.Second, app.AppMaxSize, nil)
 
 	if err != nil {
 		return &pb.VerUpURLResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
@@ -923,7 +1084,7 @@ func (s *server) ContainerCreate(c context.Context, task *pb.ContainerCreateTask
 	if task.Info != "" {
 		err := json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.ContainerResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 	}
 
@@ -933,33 +1094,40 @@ func (s *server) ContainerCreate(c context.Context, task *pb.ContainerCreateTask
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	id := app.NewID()
+	ctime := int32(time.Now().Unix())
+	secret := app.NewSecret()
+
+	enc, err := app.EncryptSecret(secret)
 
 	if err != nil {
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	db := conn.Database(app.Db)
+	doc := bson.D{bson.E{"_id", id},
+		bson.E{"title", task.Title},
+		bson.E{"info", info},
+		bson.E{"env", task.Env},
+		bson.E{"secret", enc},
+		bson.E{"ctime", ctime},
+		bson.E{"rev", 1}}
 
-	db_container := db.Collection("container")
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	id := app.NewID()
-	ctime := int32(time.Now().Unix())
-	secret := app.NewSecret()
+		err := app.Store.Container().Create(c, doc)
+
+		if err != nil {
+			return err
+		}
 
-	_, err = db_container.InsertOne(c,
-		bson.D{bson.E{"_id", id},
-			bson.E{"title", task.Title},
-			bson.E{"info", info},
-			bson.E{"env", task.Env},
-			bson.E{"secret", secret},
-			bson.E{"ctime", ctime}})
+		return audit(c, ctx, app, "ContainerCreate", AUDIT_CONTAINER, id, nil, doc)
+	})
 
 	if err != nil {
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	a := &pb.Container{Id: id, Title: task.Title, Info: task.Info, Env: task.Env, Ctime: ctime, Secret: secret}
+	a := &pb.Container{Id: id, Title: task.Title, Info: task.Info, Env: task.Env, Ctime: ctime, Secret: secret, Rev: 1}
 
 	return &pb.ContainerResult{Errno: ERRNO_OK, Data: a}, nil
 }
@@ -979,26 +1147,91 @@ func (s *server) ContainerRemove(c context.Context, task *pb.ContainerRemoveTask
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	if task.Cascade {
 
-	if err != nil {
-		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
+		var rs bson.M
+
+		removed := &pb.Removed{}
+
+		var acs []bson.M
+
+		err = app.Store.Transaction(c, func(c context.Context) error {
+
+			var err error
+
+			rs, err = app.Store.Container().Remove(c, task.Cid, task.ExpectedRev)
+
+			if err != nil {
+				return err
+			}
+
+			acs, err = removeMany(c, app, "ac", bson.D{bson.E{"cid", task.Cid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = removeMany(c, app, "ac_history", bson.D{bson.E{"cid", task.Cid}}, task.Archive)
+
+			if err != nil {
+				return err
+			}
+
+			items, err := removeMany(c, app, "rollout_item", bson.D{bson.E{"cid", task.Cid}}, task.Archive)
 
-	db := conn.Database(app.Db)
+			if err != nil {
+				return err
+			}
+
+			removed.Ac = int32(len(acs))
+			removed.RolloutItem = int32(len(items))
+
+			err = audit(c, ctx, app, "ContainerRemove", AUDIT_CONTAINER, task.Cid, rs, nil)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range acs {
+				err = audit(c, ctx, app, "ContainerRemove", AUDIT_AC, task.Cid+"/"+dynamic.StringValue(v["appid"], ""), v, nil)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			errno, errmsg := storeErrno(err, "container")
+			return &pb.ContainerResult{Errno: errno, Errmsg: errmsg}, nil
+		}
 
-	db_container := db.Collection("container")
+		a := &pb.Container{}
+
+		setContainer(a, rs)
+
+		return &pb.ContainerResult{Errno: ERRNO_OK, Data: a, Removed: removed}, nil
+	}
 
 	var rs bson.M
 
-	err = db_container.FindOneAndDelete(c,
-		bson.D{bson.E{"_id", task.Cid}}).Decode(&rs)
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.ContainerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
+		var err error
+
+		rs, err = app.Store.Container().Remove(c, task.Cid, task.ExpectedRev)
+
+		if err != nil {
+			return err
 		}
-		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+
+		return audit(c, ctx, app, "ContainerRemove", AUDIT_CONTAINER, task.Cid, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "container")
+		return &pb.ContainerResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	a := &pb.Container{}
@@ -1024,16 +1257,6 @@ func (s *server) ContainerSet(c context.Context, task *pb.ContainerSetTask) (*pb
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_container := db.Collection("container")
-
 	set := bson.D{}
 
 	if task.Title != "" {
@@ -1044,7 +1267,11 @@ func (s *server) ContainerSet(c context.Context, task *pb.ContainerSetTask) (*pb
 
 	if task.Secret {
 		secret = app.NewSecret()
-		set = append(set, bson.E{"secret", secret})
+		enc, err := app.EncryptSecret(secret)
+		if err != nil {
+			return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		}
+		set = append(set, bson.E{"secret", enc})
 	}
 
 	var info interface{} = nil
@@ -1052,7 +1279,7 @@ func (s *server) ContainerSet(c context.Context, task *pb.ContainerSetTask) (*pb
 	if task.Info != "" {
 		err = json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.ContainerResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 		dynamic.Each(info, func(key interface{}, value interface{}) bool {
 			set = append(set, bson.E{fmt.Sprintf("info.%s", dynamic.StringValue(key, "")), value})
@@ -1071,70 +1298,67 @@ func (s *server) ContainerSet(c context.Context, task *pb.ContainerSetTask) (*pb
 
 	if len(set) == 0 {
 
-		var rs bson.M
+		rs, err := app.Store.Container().Get(c, task.Cid)
 
-		err = db_container.FindOne(c,
-			bson.D{bson.E{"_id", task.Cid}}).Decode(&rs)
+		if err == nil && task.ExpectedRev > 0 && int32(dynamic.IntValue(rs["rev"], 0)) != task.ExpectedRev {
+			err = ErrConflict
+		}
 
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.ContainerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found container"}, nil
-			}
-			return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			errno, errmsg := storeErrno(err, "container")
+			return &pb.ContainerResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setContainer(a, rs)
 
 	} else {
 
-		opts := options.FindOneAndUpdate().SetUpsert(true)
+		setOnInsert := bson.D{bson.E{"ctime", int32(time.Now().Unix())}}
 
+		if task.Upsert && !task.Secret {
+			secret = app.NewSecret()
+			enc, err := app.EncryptSecret(secret)
+			if err != nil {
+				return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			}
+			setOnInsert = append(setOnInsert, bson.E{"secret", enc})
+		}
+
+		var before bson.M
 		var rs bson.M
 
-		err = db_container.FindOneAndUpdate(c,
-			bson.D{bson.E{"_id", task.Cid}}, bson.D{bson.E{"$set", set}}, opts).Decode(&rs)
+		err = app.Store.Transaction(c, func(c context.Context) error {
 
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.ContainerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found container"}, nil
-			}
-			return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-		}
+			var err error
+
+			before = findDoc(c, app.Store.Collection("container"), bson.D{bson.E{"_id", task.Cid}})
 
-		{
-			var i = rs["info"]
-			if i == nil {
-				i = map[string]interface{}{}
-				rs["info"] = i
+			update, rev := set, task.ExpectedRev
+
+			if task.Secret {
+				update, rev = app.rotateSecret(set, before, rev)
 			}
-			dynamic.Each(info, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
-		}
 
-		{
-			var i = rs["env"]
-			if i == nil {
-				i = map[string]string{}
-				rs["env"] = i
+			rs, err = app.Store.Container().Update(c, task.Cid, &Update{Set: update, SetOnInsert: setOnInsert, Rev: rev, Upsert: task.Upsert})
+
+			if err != nil {
+				return err
 			}
-			dynamic.Each(task.Env, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
-		}
 
-		if task.Title != "" {
-			rs["title"] = task.Title
-		}
+			return audit(c, ctx, app, "ContainerSet", AUDIT_CONTAINER, task.Cid, before, rs)
+		})
 
-		if task.Secret {
-			rs["title"] = secret
+		if err != nil {
+			errno, errmsg := storeErrno(err, "container")
+			return &pb.ContainerResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setContainer(a, rs)
 
+		if task.Secret || before == nil {
+			a.Secret = secret
+		}
+
 	}
 
 	return &pb.ContainerResult{Errno: ERRNO_OK, Data: a}, nil
@@ -1156,28 +1380,13 @@ func (s *server) ContainerGet(c context.Context, task *pb.ContainerGetTask) (*pb
 		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_container := db.Collection("container")
-
 	a := &pb.Container{}
 
-	var rs bson.M
-
-	err = db_container.FindOne(c,
-		bson.D{bson.E{"_id", task.Cid}}).Decode(&rs)
+	rs, err := app.Store.Container().Get(c, task.Cid)
 
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.ContainerResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found container"}, nil
-		}
-		return &pb.ContainerResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "container")
+		return &pb.ContainerResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	setContainer(a, rs)
@@ -1196,130 +1405,152 @@ func (s *server) ContainerQuery(c context.Context, task *pb.ContainerQueryTask)
 		return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	q := &Query{Where: bson.D{},
+		Q:          task.Q,
+		Match:      task.Match,
+		IgnoreCase: task.IgnoreCase,
+		Filter:     task.Filter,
+		P:          task.P,
+		N:          task.N,
+		Cursor:     task.Cursor,
+		Count:      task.Count}
+
+	err = q.Check()
 
 	if err != nil {
-		return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.ContainerQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 	}
 
-	n := task.N
-	p := task.P
+	rs, err := app.Store.Container().Query(c, q)
 
-	if n < 1 {
-		n = 20
+	if err != nil {
+		return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	rs := &pb.ContainerQueryResult{}
-
-	db := conn.Database(app.Db)
+	return &pb.ContainerQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toContainerItems(rs.Items), Cursor: rs.Cursor}, nil
+}
 
-	db_container := db.Collection("container")
+func (s *server) AcAdd(c context.Context, task *pb.AcAddTask) (*pb.AcResult, error) {
 
-	opts := options.Find().SetSort(bson.D{bson.E{"ctime", -1}}).SetLimit(int64(n))
+	ctx := grpc.GetContext(c)
 
-	filter := bson.D{}
+	defer ctx.Recycle()
 
-	if task.Q != "" {
-		filter = append(filter, bson.E{"title", bson.E{"$regex", task.Q}})
+	if task.Cid == "" {
+		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"}, nil
 	}
 
-	if p > 0 {
-
-		opts = opts.SetSkip(int64(n * (p - 1)))
+	if task.Appid == "" {
+		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
+	}
 
-		totalCount, err := db_container.CountDocuments(c, filter)
+	var info interface{} = nil
 
+	if task.Info != "" {
+		err := json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.AcResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
+	}
 
-		count := int32(totalCount) / n
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
+
+	if task.Channel != "" {
 
-		if int32(totalCount)%n != 0 {
-			count = count + 1
+		if task.Ver != "" {
+			return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "param ver and channel are exclusive"}, nil
 		}
 
-		rs.Page = &pb.Page{P: p, N: n, TotalCount: int32(totalCount), Count: count}
+		ver, errno, err := channelVer(c, app, task.Appid, task.Channel)
+
+		if err != nil {
+			return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
+		}
 
+		task.Ver = ver
 	}
 
-	cursor, err := db_container.Find(c, filter, opts)
+	ver, verRange, errno, err := resolveAcVer(c, app, task.Appid, task.Ver)
 
 	if err != nil {
-		return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
 	}
 
-	defer cursor.Close(c)
-
-	var items []bson.M
+	task.Ver = ver
 
-	err = cursor.All(c, &items)
+	errno, err = checkAc(c, app, task.Cid, task.Appid, task.Ver)
 
 	if err != nil {
-		return &pb.ContainerQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
 	}
 
-	rs.Items = toContainerItems(items)
-	rs.Errno = ERRNO_OK
+	ctime := int32(time.Now().Unix())
 
-	return rs, nil
-}
+	doc := bson.D{bson.E{"cid", task.Cid},
+		bson.E{"appid", task.Appid},
+		bson.E{"title", task.Title},
+		bson.E{"info", info},
+		bson.E{"env", task.Env},
+		bson.E{"ver", task.Ver},
+		bson.E{"range", verRange},
+		bson.E{"channel", task.Channel},
+		bson.E{"ctime", ctime}}
 
-func (s *server) AcAdd(c context.Context, task *pb.AcAddTask) (*pb.AcResult, error) {
+	rev := int32(1)
 
-	ctx := grpc.GetContext(c)
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	defer ctx.Recycle()
+		var err error
 
-	if task.Cid == "" {
-		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"}, nil
-	}
+		rev, err = nextAcRev(c, app, task.Cid, task.Appid)
 
-	if task.Appid == "" {
-		return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param appid"}, nil
-	}
+		if err != nil {
+			return err
+		}
 
-	var info interface{} = nil
+		doc := append(doc[:len(doc):len(doc)], bson.E{"rev", rev})
+
+		err = app.Store.Ac().Create(c, doc)
 
-	if task.Info != "" {
-		err := json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return err
 		}
-	}
 
-	app, err := GetAppService(ctx, SERVICE_APP)
+		err = acHistory(c, app, "AcAdd", nil, bson.M{"cid": task.Cid, "appid": task.Appid, "rev": rev, "ver": task.Ver, "range": verRange, "channel": task.Channel, "env": task.Env})
 
-	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
+		if err != nil {
+			return err
+		}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+		return audit(c, ctx, app, "AcAdd", AUDIT_AC, task.Cid+"/"+task.Appid, nil, doc)
+	})
 
-	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
+	if err == ErrDuplicate && task.IfNotExists {
 
-	db := conn.Database(app.Db)
+		rs, err := app.Store.Ac().Get(c, task.Cid, task.Appid)
 
-	db_ac := db.Collection("ac")
+		if err != nil {
+			errno, errmsg := storeErrno(err, "ac")
+			return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
+		}
 
-	ctime := int32(time.Now().Unix())
+		a := &pb.Ac{}
 
-	_, err = db_ac.InsertOne(c,
-		bson.D{bson.E{"cid", task.Cid},
-			bson.E{"appid", task.Appid},
-			bson.E{"title", task.Title},
-			bson.E{"info", info},
-			bson.E{"env", task.Env},
-			bson.E{"ver", task.Ver},
-			bson.E{"ctime", ctime}})
+		setAc(a, rs)
+
+		return &pb.AcResult{Errno: ERRNO_OK, Data: a}, nil
+	}
 
 	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "ac")
+		return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
-	a := &pb.Ac{Cid: task.Cid, Appid: task.Appid, Title: task.Title, Info: task.Info, Env: task.Env, Ver: task.Ver, Ctime: ctime}
+	a := &pb.Ac{Cid: task.Cid, Appid: task.Appid, Title: task.Title, Info: task.Info, Env: task.Env, Ver: task.Ver, VerRange: verRange, Channel: task.Channel, Ctime: ctime, Rev: rev}
 
 	return &pb.AcResult{Errno: ERRNO_OK, Data: a}, nil
 }
@@ -1343,26 +1574,30 @@ func (s *server) AcRemove(c context.Context, task *pb.AcRemoveTask) (*pb.AcResul
 		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	var rs bson.M
 
-	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
+	err = app.Store.Transaction(c, func(c context.Context) error {
 
-	db := conn.Database(app.Db)
+		var err error
 
-	db_ac := db.Collection("ac")
+		rs, err = app.Store.Ac().Remove(c, task.Cid, task.Appid, task.ExpectedRev)
 
-	var rs bson.M
+		if err != nil {
+			return err
+		}
 
-	err = db_ac.FindOneAndDelete(c,
-		bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}}).Decode(&rs)
+		err = acRemoveHistory(c, app, "AcRemove", rs)
 
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
+		if err != nil {
+			return err
 		}
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+
+		return audit(c, ctx, app, "AcRemove", AUDIT_AC, task.Cid+"/"+task.Appid, rs, nil)
+	})
+
+	if err != nil {
+		errno, errmsg := storeErrno(err, "ac")
+		return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	a := &pb.Ac{}
@@ -1391,15 +1626,34 @@ func (s *server) AcSet(c context.Context, task *pb.AcSetTask) (*pb.AcResult, err
 		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	if task.Channel != "" {
+
+		if task.Ver != "" {
+			return &pb.AcResult{Errno: ERRNO_INPUT_DATA, Errmsg: "param ver and channel are exclusive"}, nil
+		}
+
+		ver, errno, err := channelVer(c, app, task.Appid, task.Channel)
+
+		if err != nil {
+			return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
+		}
+
+		task.Ver = ver
+	}
+
+	ver, verRange, errno, err := resolveAcVer(c, app, task.Appid, task.Ver)
 
 	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
 	}
 
-	db := conn.Database(app.Db)
+	task.Ver = ver
+
+	errno, err = checkAc(c, app, task.Cid, task.Appid, task.Ver)
 
-	db_ac := db.Collection("ac")
+	if err != nil {
+		return &pb.AcResult{Errno: errno, Errmsg: err.Error()}, nil
+	}
 
 	set := bson.D{}
 
@@ -1412,7 +1666,7 @@ func (s *server) AcSet(c context.Context, task *pb.AcSetTask) (*pb.AcResult, err
 	if task.Info != "" {
 		err = json.Unmarshal([]byte(task.Info), &info)
 		if err != nil {
-			return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			return &pb.AcResult{Errno: ERRNO_INPUT_JSON, Errmsg: fmt.Sprintf("param info invalid json: %s", err.Error())}, nil
 		}
 		dynamic.Each(info, func(key interface{}, value interface{}) bool {
 			set = append(set, bson.E{fmt.Sprintf("info.%s", dynamic.StringValue(key, "")), value})
@@ -1428,77 +1682,60 @@ func (s *server) AcSet(c context.Context, task *pb.AcSetTask) (*pb.AcResult, err
 	}
 
 	if task.Ver != "" {
-		set = append(set, bson.E{"ver", task.Ver})
+		set = append(set, bson.E{"ver", task.Ver}, bson.E{"range", verRange}, bson.E{"channel", task.Channel})
 	}
 
 	a := &pb.Ac{}
 
 	if len(set) == 0 {
 
-		var rs bson.M
+		rs, err := app.Store.Ac().Get(c, task.Cid, task.Appid)
 
-		err = db_ac.FindOne(c,
-			bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}}).Decode(&rs)
+		if err == nil && task.ExpectedRev > 0 && int32(dynamic.IntValue(rs["rev"], 0)) != task.ExpectedRev {
+			err = ErrConflict
+		}
 
 		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-			}
-			return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+			errno, errmsg := storeErrno(err, "ac")
+			return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setAc(a, rs)
 
 	} else {
 
-		opts := options.FindOneAndUpdate().SetUpsert(true)
+		setOnInsert := bson.D{bson.E{"ctime", int32(time.Now().Unix())}}
 
+		var before bson.M
 		var rs bson.M
 
-		err = db_ac.FindOneAndUpdate(c,
-			bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}}, bson.D{bson.E{"$set", set}}, opts).Decode(&rs)
+		err = app.Store.Transaction(c, func(c context.Context) error {
 
-		if err != nil {
-			if err == mongo.ErrNoDocuments {
-				return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found app"}, nil
-			}
-			return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-		}
+			var err error
+
+			before = findDoc(c, app.Store.Collection("ac"), bson.D{bson.E{"cid", task.Cid}, bson.E{"appid", task.Appid}})
+
+			rs, err = app.Store.Ac().Update(c, task.Cid, task.Appid, &Update{Set: set, SetOnInsert: setOnInsert, Rev: task.ExpectedRev, Upsert: task.Upsert})
 
-		{
-			var i = rs["info"]
-			if i == nil {
-				i = map[string]interface{}{}
-				rs["info"] = i
+			if err != nil {
+				return err
 			}
-			dynamic.Each(info, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
-		}
 
-		{
-			var i = rs["env"]
-			if i == nil {
-				i = map[string]string{}
-				rs["env"] = i
+			err = acHistory(c, app, "AcSet", before, rs)
+
+			if err != nil {
+				return err
 			}
-			dynamic.Each(task.Env, func(key interface{}, value interface{}) bool {
-				dynamic.Set(i, dynamic.StringValue(key, ""), value)
-				return true
-			})
-		}
 
-		if task.Title != "" {
-			rs["title"] = task.Title
-		}
+			return audit(c, ctx, app, "AcSet", AUDIT_AC, task.Cid+"/"+task.Appid, before, rs)
+		})
 
-		if task.Ver != "" {
-			rs["ver"] = task.Ver
+		if err != nil {
+			errno, errmsg := storeErrno(err, "ac")
+			return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
 		}
 
 		setAc(a, rs)
-
 	}
 
 	return &pb.AcResult{Errno: ERRNO_OK, Data: a}, nil
@@ -1523,28 +1760,13 @@ func (s *server) AcGet(c context.Context, task *pb.AcGetTask) (*pb.AcResult, err
 		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
-
-	if err != nil {
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
-	}
-
-	db := conn.Database(app.Db)
-
-	db_ac := db.Collection("ac")
-
 	a := &pb.Ac{}
 
-	var rs bson.M
-
-	err = db_ac.FindOne(c,
-		bson.D{bson.E{"cid", task.Cid}, bson.E{"Appid", task.Appid}}).Decode(&rs)
+	rs, err := app.Store.Ac().Get(c, task.Cid, task.Appid)
 
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return &pb.AcResult{Errno: ERRNO_NOT_FOUND, Errmsg: "not found ac"}, nil
-		}
-		return &pb.AcResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		errno, errmsg := storeErrno(err, "ac")
+		return &pb.AcResult{Errno: errno, Errmsg: errmsg}, nil
 	}
 
 	setAc(a, rs)
@@ -1568,81 +1790,109 @@ func (s *server) AcQuery(c context.Context, task *pb.AcQueryTask) (*pb.AcQueryRe
 		return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
+	where := bson.D{}
+
+	if task.Cid != "" {
+		where = append(where, bson.E{"cid", task.Cid})
+	}
+
+	if task.Appid != "" {
+		where = append(where, bson.E{"appid", task.Appid})
+	}
+
+	if task.Channel != "" {
+		where = append(where, bson.E{"channel", task.Channel})
+	}
+
+	q := &Query{Where: where,
+		Q:          task.Q,
+		Match:      task.Match,
+		IgnoreCase: task.IgnoreCase,
+		Filter:     task.Filter,
+		P:          task.P,
+		N:          task.N,
+		Cursor:     task.Cursor,
+		Count:      task.Count}
+
+	err = q.Check()
 
 	if err != nil {
-		return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AcQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 	}
 
-	n := task.N
-	p := task.P
+	rs, err := app.Store.Ac().Query(c, q)
 
-	if n < 1 {
-		n = 20
+	if err != nil {
+		return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	rs := &pb.AcQueryResult{}
+	return &pb.AcQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toAcItems(rs.Items), Cursor: rs.Cursor}, nil
+}
+
+func (s *server) AuditQuery(c context.Context, task *pb.AuditQueryTask) (*pb.AuditQueryResult, error) {
+
+	ctx := grpc.GetContext(c)
 
-	db := conn.Database(app.Db)
+	defer ctx.Recycle()
 
-	db_ac := db.Collection("ac")
+	app, err := GetAppService(ctx, SERVICE_APP)
 
-	opts := options.Find().SetSort(bson.D{bson.E{"ctime", -1}}).SetLimit(int64(n))
+	if err != nil {
+		return &pb.AuditQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+	}
 
 	filter := bson.D{}
 
-	if task.Cid != "" {
-		filter = append(filter, bson.E{"cid", task.Cid})
+	if task.Type != "" {
+		filter = append(filter, bson.E{"etype", task.Type})
 	}
 
-	if task.Appid != "" {
-		filter = append(filter, bson.E{"appid", task.Appid})
+	if task.Eid != "" {
+		filter = append(filter, bson.E{"eid", task.Eid})
 	}
 
-	if task.Q != "" {
-		filter = append(filter, bson.E{"title", bson.E{"$regex", task.Q}})
+	if task.Actor != "" {
+		filter = append(filter, bson.E{"actor", task.Actor})
 	}
 
-	if p > 0 {
+	if task.Method != "" {
+		filter = append(filter, bson.E{"method", task.Method})
+	}
 
-		opts = opts.SetSkip(int64(n * (p - 1)))
+	if task.Start > 0 || task.End > 0 {
 
-		totalCount, err := db_ac.CountDocuments(c, filter)
+		ctime := bson.D{}
 
-		if err != nil {
-			return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		if task.Start > 0 {
+			ctime = append(ctime, bson.E{"$gte", task.Start})
 		}
 
-		count := int32(totalCount) / n
-
-		if int32(totalCount)%n != 0 {
-			count = count + 1
+		if task.End > 0 {
+			ctime = append(ctime, bson.E{"$lt", task.End})
 		}
 
-		rs.Page = &pb.Page{P: p, N: n, TotalCount: int32(totalCount), Count: count}
-
+		filter = append(filter, bson.E{"ctime", ctime})
 	}
 
-	cursor, err := db_ac.Find(c, filter, opts)
+	q := &Query{Where: filter,
+		P:      task.P,
+		N:      task.N,
+		Cursor: task.Cursor,
+		Count:  task.Count}
+
+	err = q.Check()
 
 	if err != nil {
-		return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AuditQueryResult{Errno: ERRNO_INPUT_DATA, Errmsg: err.Error()}, nil
 	}
 
-	defer cursor.Close(c)
-
-	var items []bson.M
-
-	err = cursor.All(c, &items)
+	rs, err := app.Store.Collection("audit").Query(c, q)
 
 	if err != nil {
-		return &pb.AcQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
+		return &pb.AuditQueryResult{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()}, nil
 	}
 
-	rs.Items = toAcItems(items)
-	rs.Errno = ERRNO_OK
-
-	return rs, nil
+	return &pb.AuditQueryResult{Errno: ERRNO_OK, Page: rs.Page, Items: toAuditItems(rs.Items), Cursor: rs.Cursor}, nil
 }
 
 func Reg(s *G.Server) {
@@ -0,0 +1,416 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ability-sh/abi-lib/iid"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 测试用上下文，服务只包含 AppService
+**/
+type testContext struct {
+	values   map[string]string
+	services map[string]micro.Service
+}
+
+func (c *testContext) Recycle() {}
+
+func (c *testContext) Id() string {
+	return "test"
+}
+
+func (c *testContext) Path() string {
+	return "/"
+}
+
+func (c *testContext) Trace() string {
+	return "test"
+}
+
+func (c *testContext) GetValue(key string) string {
+	return c.values[key]
+}
+
+func (c *testContext) SetValue(key string, value string) {
+	c.values[key] = value
+}
+
+func (c *testContext) Each(fn func(key string, value string) bool) {
+	for key, value := range c.values {
+		if !fn(key, value) {
+			break
+		}
+	}
+}
+
+func (c *testContext) AddCount(key string, count int) {}
+
+func (c *testContext) AddTag(key string, value string) {}
+
+func (c *testContext) GetService(name string) (micro.Service, error) {
+	s, ok := c.services[name]
+	if !ok {
+		return nil, fmt.Errorf("not found service %s", name)
+	}
+	return s, nil
+}
+
+func (c *testContext) Runtime() micro.Runtime {
+	return nil
+}
+
+func (c *testContext) Payload() micro.Payload {
+	return nil
+}
+
+func (c *testContext) Println(v ...interface{}) {}
+
+func (c *testContext) Printf(format string, v ...interface{}) {}
+
+func (c *testContext) Step(step string) micro.Step {
+	return func(format string, v ...interface{}) {}
+}
+
+func (c *testContext) BeginStep(step string) {}
+
+func (c *testContext) EndStep(format string, v ...interface{}) {}
+
+func newTestApp() *AppService {
+	return &AppService{name: SERVICE_APP,
+		IID:       iid.NewIID(1, 1),
+		SecretKey: "test",
+		Store:     newMemoryStore()}
+}
+
+func newTestContext(app *AppService) context.Context {
+	ctx := &testContext{values: map[string]string{}, services: map[string]micro.Service{SERVICE_APP: app}}
+	return micro.WithContext(context.Background(), ctx)
+}
+
+func TestAppHandlers(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	rs, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Rev != 1 {
+		t.Fatalf("AppCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	appid := rs.Data.Id
+
+	err := app.Store.App().Create(c, bson.D{bson.E{"_id", appid}, bson.E{"title", "dup"}})
+
+	if err != ErrDuplicate {
+		t.Fatalf("App Create duplicate %v", err)
+	}
+
+	rs, _ = s.AppSet(c, &pb.AppSetTask{Appid: appid, Title: "x", ExpectedRev: 2})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("AppSet rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.AppSet(c, &pb.AppSetTask{Appid: appid, Title: "x", ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Title != "x" || rs.Data.Rev != 2 {
+		t.Fatalf("AppSet %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.AppRemove(c, &pb.AppRemoveTask{Appid: appid, ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("AppRemove rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.AppRemove(c, &pb.AppRemoveTask{Appid: appid, ExpectedRev: 2})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AppRemove %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.AppGet(c, &pb.AppGetTask{Appid: appid})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("AppGet removed %d", rs.Errno)
+	}
+}
+
+func TestContainerHandlers(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	rs, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo", Env: map[string]string{"a": "1"}})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("ContainerCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	cid := rs.Data.Id
+
+	err := app.Store.Container().Create(c, bson.D{bson.E{"_id", cid}})
+
+	if err != ErrDuplicate {
+		t.Fatalf("Container Create duplicate %v", err)
+	}
+
+	rs, _ = s.ContainerSet(c, &pb.ContainerSetTask{Cid: cid, Env: map[string]string{"b": "2"}, ExpectedRev: 3})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("ContainerSet rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.ContainerSet(c, &pb.ContainerSetTask{Cid: cid, Env: map[string]string{"b": "2"}, ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Env["a"] != "1" || rs.Data.Env["b"] != "2" || rs.Data.Rev != 2 {
+		t.Fatalf("ContainerSet %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.ContainerRemove(c, &pb.ContainerRemoveTask{Cid: cid, ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("ContainerRemove rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.ContainerRemove(c, &pb.ContainerRemoveTask{Cid: cid})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("ContainerRemove %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.ContainerRemove(c, &pb.ContainerRemoveTask{Cid: cid})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("ContainerRemove removed %d", rs.Errno)
+	}
+}
+
+func TestVerHandlers(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+
+	rs, _ := s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0", Title: "v1"})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("VerCreate %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_DUPLICATE {
+		t.Fatalf("VerCreate duplicate %d", rs.Errno)
+	}
+
+	rs, _ = s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0", IfNotExists: true})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Title != "v1" {
+		t.Fatalf("VerCreate ifNotExists %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.VerCreate(c, &pb.VerCreateTask{Appid: "none", Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("VerCreate not found app %d", rs.Errno)
+	}
+
+	rs, _ = s.VerSet(c, &pb.VerSetTask{Appid: appid, Ver: "1.0.0", Title: "x", ExpectedRev: 2})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("VerSet rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.VerSet(c, &pb.VerSetTask{Appid: appid, Ver: "1.0.0", Title: "x", Status: "VER_UPLOADED", ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Title != "x" || rs.Data.Status != pb.VerStatus_VER_UPLOADED || len(rs.Data.Transitions) != 1 {
+		t.Fatalf("VerSet %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.VerSet(c, &pb.VerSetTask{Appid: appid, Ver: "2.0.0", Title: "v2", Upsert: true})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Rev != 1 {
+		t.Fatalf("VerSet upsert %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.VerRemove(c, &pb.VerRemoveTask{Appid: appid, Ver: "1.0.0", ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("VerRemove rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.VerRemove(c, &pb.VerRemoveTask{Appid: appid, Ver: "1.0.0", ExpectedRev: 2})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("VerRemove %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.VerGet(c, &pb.VerGetTask{Appid: appid, Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("VerGet removed %d", rs.Errno)
+	}
+}
+
+func TestAcHandlers(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.1.0"})
+
+	rs, _ := s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0", Env: map[string]string{"a": "1"}})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AcAdd %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0"})
+
+	if rs.Errno != ERRNO_DUPLICATE {
+		t.Fatalf("AcAdd duplicate %d", rs.Errno)
+	}
+
+	rs, _ = s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.1.0", IfNotExists: true})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.0.0" {
+		t.Fatalf("AcAdd ifNotExists %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.AcSet(c, &pb.AcSetTask{Cid: cid, Appid: appid, Ver: "1.1.0", ExpectedRev: 2})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("AcSet rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.AcSet(c, &pb.AcSetTask{Cid: cid, Appid: appid, Ver: "1.1.0", ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.1.0" || rs.Data.Env["a"] != "1" || rs.Data.Rev != 2 {
+		t.Fatalf("AcSet %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	h, _ := s.AcHistory(c, &pb.AcHistoryTask{Cid: cid, Appid: appid})
+
+	if h.Errno != ERRNO_OK || len(h.Items) != 2 || h.Items[0].Ver != "1.1.0" {
+		t.Fatalf("AcHistory %d %s %v", h.Errno, h.Errmsg, h.Items)
+	}
+
+	rs, _ = s.AcRollback(c, &pb.AcRollbackTask{Cid: cid, Appid: appid})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.0.0" || rs.Data.Rev != 3 {
+		t.Fatalf("AcRollback %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.AcRemove(c, &pb.AcRemoveTask{Cid: cid, Appid: appid, ExpectedRev: 1})
+
+	if rs.Errno != ERRNO_CONFLICT {
+		t.Fatalf("AcRemove rev conflict %d", rs.Errno)
+	}
+
+	rs, _ = s.AcRemove(c, &pb.AcRemoveTask{Cid: cid, Appid: appid, ExpectedRev: 3})
+
+	if rs.Errno != ERRNO_OK {
+		t.Fatalf("AcRemove %d %s", rs.Errno, rs.Errmsg)
+	}
+
+	rs, _ = s.AcGet(c, &pb.AcGetTask{Cid: cid, Appid: appid})
+
+	if rs.Errno != ERRNO_NOT_FOUND {
+		t.Fatalf("AcGet removed %d", rs.Errno)
+	}
+
+	h, _ = s.AcHistory(c, &pb.AcHistoryTask{Cid: cid, Appid: appid})
+
+	if h.Errno != ERRNO_OK || len(h.Items) != 4 || h.Items[0].Method != "AcRemove" || h.Items[0].Rev != 4 {
+		t.Fatalf("AcHistory removed %d %v", h.Errno, h.Items)
+	}
+
+	/* 重新添加后接续变更记录，可回退到删除前的状态 */
+
+	rs, _ = s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.1.0"})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Rev != 5 {
+		t.Fatalf("AcAdd after remove %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+
+	rs, _ = s.AcRollback(c, &pb.AcRollbackTask{Cid: cid, Appid: appid})
+
+	if rs.Errno != ERRNO_OK || rs.Data.Ver != "1.0.0" || rs.Data.Env["a"] != "1" {
+		t.Fatalf("AcRollback after re-add %d %s %v", rs.Errno, rs.Errmsg, rs.Data)
+	}
+}
+
+func TestCascadeRemove(t *testing.T) {
+
+	app := newTestApp()
+	c := newTestContext(app)
+	s := &server{}
+
+	a, _ := s.AppCreate(c, &pb.AppCreateTask{Title: "demo"})
+	ct, _ := s.ContainerCreate(c, &pb.ContainerCreateTask{Title: "demo"})
+
+	appid := a.Data.Id
+	cid := ct.Data.Id
+
+	s.VerCreate(c, &pb.VerCreateTask{Appid: appid, Ver: "1.0.0"})
+	s.AcAdd(c, &pb.AcAddTask{Cid: cid, Appid: appid, Ver: "1.0.0"})
+
+	items := app.Store.Collection("rollout_item")
+
+	items.Create(c, bson.D{bson.E{"rollout_id", "r1"}, bson.E{"cid", cid}, bson.E{"appid", appid}})
+	items.Create(c, bson.D{bson.E{"rollout_id", "r1"}, bson.E{"cid", "other"}, bson.E{"appid", appid}})
+
+	rs, _ := s.ContainerRemove(c, &pb.ContainerRemoveTask{Cid: cid, Cascade: true, Archive: true})
+
+	if rs.Errno != ERRNO_OK || rs.Removed.Ac != 1 || rs.Removed.RolloutItem != 1 {
+		t.Fatalf("ContainerRemove cascade %d %s %v", rs.Errno, rs.Errmsg, rs.Removed)
+	}
+
+	n, _ := items.Count(c, bson.D{bson.E{"cid", cid}})
+
+	if n != 0 {
+		t.Fatalf("rollout_item not removed %d", n)
+	}
+
+	n, _ = app.Store.Collection("ac_archive").Count(c, bson.D{bson.E{"cid", cid}})
+
+	if n != 1 {
+		t.Fatalf("ac_archive %d", n)
+	}
+
+	/* 未配置 oss 时包文件删除失败，数据删除仍然成功 */
+
+	r, _ := s.AppRemove(c, &pb.AppRemoveTask{Appid: appid, Cascade: true, Ability: []string{"web"}})
+
+	if r.Errno != ERRNO_OK || r.Removed.Ver != 1 || r.Removed.RolloutItem != 1 || len(r.Removed.OssFailed) != 1 {
+		t.Fatalf("AppRemove cascade %d %s %v", r.Errno, r.Errmsg, r.Removed)
+	}
+
+	n, _ = app.Store.Collection("ver").Count(c, bson.D{bson.E{"appid", appid}})
+
+	if n != 0 {
+		t.Fatalf("ver not removed %d", n)
+	}
+}
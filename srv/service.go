@@ -22,16 +22,32 @@ const (
 )
 
 type AppService struct {
-	config     interface{} `json:"-"`
-	name       string      `json:"-"`
-	Prefix     string      `json:"prefix"`
-	BasePath   string      `json:"basePath"`
-	Aid        int64       `json:"aid"`          //区域ID
-	Nid        int64       `json:"nid"`          //节点ID
-	Expires    int64       `json:"expires"`      //过期秒数
-	Db         string      `json:"db"`           // mongodb db
-	AppMaxSize int64       `json:"app-max-size"` // 应用包最大字节数
-	IID        *iid.IID    `json:"-"`
+	config             interface{} `json:"-"`
+	name               string      `json:"-"`
+	Prefix             string      `json:"prefix"`
+	BasePath           string      `json:"basePath"`
+	Aid                int64       `json:"aid"`                 //区域ID
+	Nid                int64       `json:"nid"`                 //节点ID
+	Expires            int64       `json:"expires"`             //过期秒数
+	Db                 string      `json:"db"`                  // mongodb db
+	AppMaxSize         int64       `json:"app-max-size"`        // 应用包最大字节数
+	AcPublished        bool        `json:"ac-published"`        // 容器绑定的版本必须为已发布状态
+	PublishedStatus    int32       `json:"published-status"`    // 旧版本数据中的已发布状态值，仅用于状态迁移，默认 0
+	LegacyStatus       interface{} `json:"legacy-status"`       // 旧版本数据的状态值迁移到的状态名，如 {"5":"VER_YANKED"}
+	Auth               bool        `json:"auth"`                // 开启调用鉴权
+	AdminToken         string      `json:"admin-token"`         // 管理员凭证
+	AuthExpires        int64       `json:"auth-expires"`        // 签名有效秒数，默认 300，有效期内 nonce 不可重复
+	SecretKey          string      `json:"secret-key"`          // 密钥加密key，必须配置
+	SecretGrace        int64       `json:"secret-grace"`        // 密钥轮换后旧密钥有效秒数
+	StatusError        bool        `json:"status-error"`        // 错误时同时返回 grpc 状态错误，可由请求 metadata status-error 覆盖
+	Storage            string      `json:"storage"`             // 存储后端 mongodb(默认)、memory 或 bolt，非 mongodb 时不初始化 mongodb
+	StoragePath        string      `json:"storage-path"`        // bolt 数据文件路径
+	IdempotencyExpires int64       `json:"idempotency-expires"` // 幂等键有效秒数，默认 86400
+	IdempotencyLease   int64       `json:"idempotency-lease"`   // 处理中的幂等键租约秒数，默认 60
+	IID                *iid.IID    `json:"-"`
+	Store              Store       `json:"-"`
+	kv                 *boltKV
+	nonces             nonceSet
 }
 
 func newAppService(name string, config interface{}) *AppService {
@@ -59,10 +75,80 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 
 	dynamic.SetValue(s, s.config)
 
+	if s.SecretKey == "" {
+		return fmt.Errorf("not found config secret-key")
+	}
+
 	s.IID = iid.NewIID(s.Aid, s.Nid)
 
+	switch s.Storage {
+	case "", STORAGE_MONGODB:
+		err := s.initMongo(ctx)
+		if err != nil {
+			return err
+		}
+	case STORAGE_MEMORY:
+		s.Store = newMemoryStore()
+		ctx.Printf("storage %s", s.Storage)
+	case STORAGE_BOLT:
+		if s.StoragePath == "" {
+			return fmt.Errorf("not found storage-path")
+		}
+		kv, err := newBoltKV(s.StoragePath)
+		if err != nil {
+			return err
+		}
+		s.kv = kv
+		s.Store = newKVStore(kv)
+		ctx.Printf("storage %s %s", s.Storage, s.StoragePath)
+	default:
+		return fmt.Errorf("unsupported storage %s", s.Storage)
+	}
+
 	ctx.Printf("db init ...")
 
+	c := context.Background()
+
+	err := initRoles(c, s)
+
+	if err != nil {
+		return err
+	}
+
+	err = encryptSecrets(c, s)
+
+	if err != nil {
+		return err
+	}
+
+	err = backfillVkeys(c, s)
+
+	if err != nil {
+		return err
+	}
+
+	err = migrateVerStatus(c, s)
+
+	if err != nil {
+		return err
+	}
+
+	err = migrateVerPackages(c, s)
+
+	if err != nil {
+		return err
+	}
+
+	ctx.Printf("db init done")
+
+	return nil
+}
+
+/**
+* 连接 mongodb 并创建索引
+**/
+func (s *AppService) initMongo(ctx micro.Context) error {
+
 	conn, err := mongodb.GetClient(ctx, SERVICE_MONGODB)
 
 	if err != nil {
@@ -73,16 +159,35 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 
 	c := context.Background()
 
+	s.Store, err = newMongoStore(c, db)
+
+	if err != nil {
+		return err
+	}
+
 	db_app := db.Collection("app")
 	db_ver := db.Collection("ver")
 	db_container := db.Collection("container")
 	db_ac := db.Collection("ac")
+	db_audit := db.Collection("audit")
+	db_user := db.Collection("user")
+	db_role := db.Collection("role")
+	db_role_binding := db.Collection("role_binding")
+	db_channel := db.Collection("channel")
+	db_channel_history := db.Collection("channel_history")
+	db_ac_history := db.Collection("ac_history")
+	db_rollout := db.Collection("rollout")
+	db_rollout_item := db.Collection("rollout_item")
+	db_idempotency := db.Collection("idempotency")
 
 	{
 		indexes := db_app.Indexes()
 		_, err = indexes.CreateMany(c, []mongo.IndexModel{
 			{
-				Keys: bson.D{bson.E{"ctime", -1}},
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"title", "text"}},
 			},
 		})
 		if err != nil {
@@ -97,9 +202,18 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 				Keys:    bson.D{bson.E{"appid", -1}, bson.E{"ver", -1}},
 				Options: options.Index().SetUnique(true),
 			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"vkey", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
 			{
 				Keys: bson.D{bson.E{"ctime", -1}},
 			},
+			{
+				Keys: bson.D{bson.E{"title", "text"}},
+			},
 		})
 		if err != nil {
 			return err
@@ -110,7 +224,10 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 		indexes := db_container.Indexes()
 		_, err = indexes.CreateMany(c, []mongo.IndexModel{
 			{
-				Keys: bson.D{bson.E{"ctime", -1}},
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"title", "text"}},
 			},
 		})
 		if err != nil {
@@ -125,6 +242,76 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 				Keys:    bson.D{bson.E{"cid", -1}, bson.E{"appid", -1}},
 				Options: options.Index().SetUnique(true),
 			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"channel", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"title", "text"}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_audit.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"etype", -1}, bson.E{"eid", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"actor", -1}, bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_user.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"title", "text"}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_role.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_role_binding.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys:    bson.D{bson.E{"uid", -1}, bson.E{"role", -1}, bson.E{"appid", -1}, bson.E{"cid", -1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{bson.E{"role", -1}},
+			},
 			{
 				Keys: bson.D{bson.E{"ctime", -1}},
 			},
@@ -134,7 +321,95 @@ func (s *AppService) OnInit(ctx micro.Context) error {
 		}
 	}
 
-	ctx.Printf("db init done")
+	{
+		indexes := db_channel.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys:    bson.D{bson.E{"appid", -1}, bson.E{"name", -1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"ver", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_channel_history.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"name", -1}, bson.E{"ctime", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_ac_history.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"cid", -1}, bson.E{"appid", -1}, bson.E{"rev", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_rollout.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"status", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}, bson.E{"ctime", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_rollout_item.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys:    bson.D{bson.E{"rollout_id", -1}, bson.E{"cid", -1}},
+				Options: options.Index().SetUnique(true),
+			},
+			{
+				Keys: bson.D{bson.E{"rollout_id", -1}, bson.E{"wave", -1}, bson.E{"status", -1}},
+			},
+			{
+				Keys: bson.D{bson.E{"appid", -1}},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	{
+		indexes := db_idempotency.Indexes()
+		_, err = indexes.CreateMany(c, []mongo.IndexModel{
+			{
+				Keys:    bson.D{bson.E{"etime", 1}},
+				Options: options.Index().SetExpireAfterSeconds(0),
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -147,7 +422,10 @@ func (s *AppService) OnValid(ctx micro.Context) error {
 }
 
 func (s *AppService) Recycle() {
-
+	if s.kv != nil {
+		s.kv.Close()
+		s.kv = nil
+	}
 }
 
 func (s *AppService) NewID() string {
@@ -160,6 +438,10 @@ func (s *AppService) NewSecret() string {
 	return hex.EncodeToString(m.Sum(nil))
 }
 
+func (s *AppService) PackageKey(appid string, ver string, ability string) string {
+	return fmt.Sprintf("%s%s/v%s-%s.zip", s.BasePath, appid, ver, ability)
+}
+
 func GetAppService(ctx micro.Context, name string) (*AppService, error) {
 	s, err := ctx.GetService(name)
 	if err != nil {
@@ -0,0 +1,138 @@
+package srv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/micro"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func initTestService(t *testing.T, config map[string]interface{}) (*AppService, context.Context) {
+
+	s := newAppService(SERVICE_APP, config)
+
+	ctx := &testContext{values: map[string]string{}, services: map[string]micro.Service{SERVICE_APP: s}}
+
+	err := s.OnInit(ctx)
+
+	if err != nil {
+		t.Fatalf("%v: %v", config, err)
+	}
+
+	return s, micro.WithContext(context.Background(), ctx)
+}
+
+/**
+* 非 mongodb 后端同样执行内置角色及 vkey 回填
+**/
+func TestOnInitKV(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "app.db")
+
+	kv, err := newBoltKV(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = newKVStore(kv).Ver().Create(context.Background(), bson.D{bson.E{"appid", "a1"}, bson.E{"ver", "1.2.0"}})
+
+	kv.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, config := range []map[string]interface{}{
+		{"storage": STORAGE_MEMORY, "secret-key": "test"},
+		{"storage": STORAGE_BOLT, "storage-path": path, "secret-key": "test"},
+	} {
+
+		s, c := initTestService(t, config)
+
+		n, err := s.Store.Collection("role").Count(c, bson.D{})
+
+		if err != nil || n != int64(len(builtinRoles)) {
+			t.Fatalf("%v: roles %d %v", config, n, err)
+		}
+
+		if config["storage"] == STORAGE_BOLT {
+
+			rs, err := s.Store.Ver().Get(c, "a1", "1.2.0")
+
+			if err != nil || rs["vkey"] == nil {
+				t.Fatalf("vkey not backfilled %v %v", rs, err)
+			}
+		}
+
+		s.Recycle()
+	}
+}
+
+/**
+* 引入状态流转前的版本在初始化时迁移，已迁移的版本不受影响
+**/
+func TestMigrateVerStatus(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "app.db")
+
+	kv, err := newBoltKV(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := newKVStore(kv)
+	c := context.Background()
+
+	for _, doc := range []bson.D{
+		{bson.E{"appid", "a1"}, bson.E{"ver", "1.0.0"}, bson.E{"status", 1}},
+		{bson.E{"appid", "a1"}, bson.E{"ver", "1.1.0"}, bson.E{"status", 0}},
+		{bson.E{"appid", "a1"}, bson.E{"ver", "1.2.0"}, bson.E{"status", 9}},
+		{bson.E{"appid", "a1"}, bson.E{"ver", "2.0.0"}, bson.E{"status", int32(pb.VerStatus_VER_TESTING)}, bson.E{"transitions", bson.A{}}},
+	} {
+		err = s.Ver().Create(c, doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	kv.Close()
+
+	app, c := initTestService(t, map[string]interface{}{
+		"storage":          STORAGE_BOLT,
+		"secret-key":       "test",
+		"storage-path":     path,
+		"ac-published":     true,
+		"published-status": 1,
+		"legacy-status":    map[string]interface{}{"9": "yanked"},
+	})
+
+	defer app.Recycle()
+
+	for ver, status := range map[string]pb.VerStatus{
+		"1.0.0": pb.VerStatus_VER_PUBLISHED,
+		"1.1.0": pb.VerStatus_VER_UPLOADED,
+		"1.2.0": pb.VerStatus_VER_YANKED,
+		"2.0.0": pb.VerStatus_VER_TESTING,
+	} {
+
+		rs, err := app.Store.Ver().Get(c, "a1", ver)
+
+		if err != nil || pb.VerStatus(dynamic.IntValue(rs["status"], -1)) != status || rs["transitions"] == nil {
+			t.Fatalf("%s: %v %v", ver, rs, err)
+		}
+	}
+
+	r, _ := ParseSemverRange("^1.0.0")
+
+	rs, err := resolveVer(c, app, "a1", r, true)
+
+	if err != nil || rs == nil || rs["ver"] != "1.0.0" {
+		t.Fatalf("resolve published %v %v", rs, err)
+	}
+}
@@ -0,0 +1,158 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 版本状态允许的流转，yanked 为终态
+**/
+var verStatusTransitions = map[pb.VerStatus][]pb.VerStatus{
+	pb.VerStatus_VER_DRAFT:      {pb.VerStatus_VER_UPLOADED, pb.VerStatus_VER_YANKED},
+	pb.VerStatus_VER_UPLOADED:   {pb.VerStatus_VER_TESTING, pb.VerStatus_VER_PUBLISHED, pb.VerStatus_VER_YANKED},
+	pb.VerStatus_VER_TESTING:    {pb.VerStatus_VER_UPLOADED, pb.VerStatus_VER_PUBLISHED, pb.VerStatus_VER_YANKED},
+	pb.VerStatus_VER_PUBLISHED:  {pb.VerStatus_VER_DEPRECATED, pb.VerStatus_VER_YANKED},
+	pb.VerStatus_VER_DEPRECATED: {pb.VerStatus_VER_PUBLISHED, pb.VerStatus_VER_YANKED},
+}
+
+/**
+* 创建版本时允许的初始状态
+**/
+var verStatusInitial = map[pb.VerStatus]bool{
+	pb.VerStatus_VER_DRAFT:     true,
+	pb.VerStatus_VER_UPLOADED:  true,
+	pb.VerStatus_VER_TESTING:   true,
+	pb.VerStatus_VER_PUBLISHED: true,
+}
+
+/**
+* 解析版本状态，支持数字、VER_PUBLISHED 或 published
+**/
+func parseVerStatus(s string) (pb.VerStatus, error) {
+
+	s = strings.TrimSpace(s)
+
+	if n, err := strconv.Atoi(s); err == nil {
+		if _, ok := pb.VerStatus_name[int32(n)]; ok {
+			return pb.VerStatus(n), nil
+		}
+		return 0, fmt.Errorf("invalid ver status %s", s)
+	}
+
+	name := strings.ToUpper(s)
+
+	if !strings.HasPrefix(name, "VER_") {
+		name = "VER_" + name
+	}
+
+	if v, ok := pb.VerStatus_value[name]; ok {
+		return pb.VerStatus(v), nil
+	}
+
+	return 0, fmt.Errorf("invalid ver status %s", s)
+}
+
+/**
+* 迁移引入状态流转前的版本(无 transitions)，旧状态值保存在 legacy_status
+* legacy-status 中配置的值按配置迁移，published-status 迁移为 VER_PUBLISHED
+* 其余值在开启 ac-published 时迁移为 VER_UPLOADED(原本不可绑定)，否则为 VER_PUBLISHED(原本均可绑定)
+**/
+func migrateVerStatus(c context.Context, app *AppService) error {
+
+	legacy := map[int32]pb.VerStatus{}
+
+	var err error
+
+	dynamic.Each(app.LegacyStatus, func(key interface{}, value interface{}) bool {
+
+		n, e := strconv.Atoi(dynamic.StringValue(key, ""))
+
+		if e != nil {
+			err = fmt.Errorf("invalid legacy-status %v", key)
+			return false
+		}
+
+		status, e := parseVerStatus(dynamic.StringValue(value, ""))
+
+		if e != nil {
+			err = fmt.Errorf("invalid legacy-status %v: %s", key, e.Error())
+			return false
+		}
+
+		legacy[int32(n)] = status
+
+		return true
+	})
+
+	if err != nil {
+		return err
+	}
+
+	db_ver := app.Store.Collection("ver")
+
+	items, err := db_ver.Find(c, bson.D{bson.E{"transitions", bson.D{bson.E{"$exists", false}}}}, nil, 0)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+
+		old := int32(dynamic.IntValue(item["status"], 0))
+
+		status, ok := legacy[old]
+
+		if !ok {
+			if old == app.PublishedStatus || !app.AcPublished {
+				status = pb.VerStatus_VER_PUBLISHED
+			} else {
+				status = pb.VerStatus_VER_UPLOADED
+			}
+		}
+
+		_, err = db_ver.Update(c, bson.D{bson.E{"appid", item["appid"]}, bson.E{"ver", item["ver"]}, bson.E{"transitions", bson.D{bson.E{"$exists", false}}}},
+			&Update{Set: bson.D{bson.E{"status", int32(status)}, bson.E{"legacy_status", old}, bson.E{"transitions", bson.A{}}}, KeepRev: true})
+
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func canVerTransition(from pb.VerStatus, to pb.VerStatus) bool {
+	for _, v := range verStatusTransitions[from] {
+		if v == to {
+			return true
+		}
+	}
+	return false
+}
+
+func verTransitionDoc(c context.Context, from pb.VerStatus, to pb.VerStatus) bson.D {
+	return bson.D{bson.E{"from", int32(from)},
+		bson.E{"to", int32(to)},
+		bson.E{"actor", getActor(c)},
+		bson.E{"ctime", int32(time.Now().Unix())}}
+}
+
+func toVerTransitions(v interface{}) []*pb.VerTransition {
+	vs := []*pb.VerTransition{}
+	dynamic.Each(v, func(_ interface{}, item interface{}) bool {
+		vs = append(vs, &pb.VerTransition{From: pb.VerStatus(dynamic.IntValue(dynamic.Get(item, "from"), 0)),
+			To:    pb.VerStatus(dynamic.IntValue(dynamic.Get(item, "to"), 0)),
+			Actor: dynamic.StringValue(dynamic.Get(item, "actor"), ""),
+			Ctime: int32(dynamic.IntValue(dynamic.Get(item, "ctime"), 0))})
+		return true
+	})
+	return vs
+}
@@ -0,0 +1,469 @@
+package srv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	STORAGE_MONGODB = "mongodb"
+	STORAGE_MEMORY  = "memory"
+	STORAGE_BOLT    = "bolt"
+)
+
+var (
+	ErrNotFound  = errors.New("not found")
+	ErrConflict  = errors.New("rev conflict")
+	ErrDuplicate = errors.New("duplicate key")
+	ErrResume    = errors.New("invalid or expired resume token")
+)
+
+/**
+* 查询条件，Sort 须以 _id 结尾且全部为降序，默认按 ctime 降序
+**/
+type Query struct {
+	Where      bson.D
+	Q          string
+	Match      pb.FilterMatch
+	IgnoreCase bool
+	Filter     *pb.Filter
+	Status     bool
+	Sort       bson.D
+	P          int32
+	N          int32
+	Cursor     string
+	Count      bool
+}
+
+type QueryResult struct {
+	Items  []bson.M
+	Page   *pb.Page
+	Cursor string
+}
+
+/**
+* 更新内容，Set 支持 info.x 形式的路径，Inc 为数值增量，Push 追加到数组字段
+* Rev 大于 0 时校验版本号，Upsert 时写入 SetOnInsert，默认 rev 加 1，KeepRev 时不修改 rev
+**/
+type Update struct {
+	Set         bson.D
+	SetOnInsert bson.D
+	Inc         bson.D
+	Push        bson.D
+	Rev         int32
+	Upsert      bool
+	KeepRev     bool
+}
+
+/**
+* 集合变更事件，Doc 为变更后的文档，Before 为删除前的文档(后端不支持时为 nil)
+* Token 用于断线后从该事件之后继续监听
+**/
+type ChangeEvent struct {
+	Type   string
+	Coll   string
+	Id     interface{}
+	Doc    bson.M
+	Before bson.M
+	Token  string
+}
+
+/**
+* 存储后端的单个集合，filter 为 mongo 查询条件，非 mongodb 后端仅支持 matchDoc 中的操作符
+**/
+type Collection interface {
+	Get(c context.Context, filter bson.D) (bson.M, error)
+	Find(c context.Context, filter bson.D, sort bson.D, limit int64) ([]bson.M, error)
+	Count(c context.Context, filter bson.D) (int64, error)
+	Query(c context.Context, q *Query) (*QueryResult, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, filter bson.D, u *Update) (bson.M, error)
+	UpdateMany(c context.Context, filter bson.D, u *Update) (int64, error)
+	Remove(c context.Context, filter bson.D, rev int32) (bson.M, error)
+	RemoveMany(c context.Context, filter bson.D) ([]bson.M, error)
+}
+
+type AppStore interface {
+	Get(c context.Context, appid string) (bson.M, error)
+	Query(c context.Context, q *Query) (*QueryResult, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, appid string, u *Update) (bson.M, error)
+	Remove(c context.Context, appid string, rev int32) (bson.M, error)
+}
+
+type VerStore interface {
+	Get(c context.Context, appid string, ver string) (bson.M, error)
+	Query(c context.Context, q *Query) (*QueryResult, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, appid string, ver string, u *Update) (bson.M, error)
+	Remove(c context.Context, appid string, ver string, rev int32) (bson.M, error)
+}
+
+type ContainerStore interface {
+	Get(c context.Context, cid string) (bson.M, error)
+	Query(c context.Context, q *Query) (*QueryResult, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, cid string, u *Update) (bson.M, error)
+	Remove(c context.Context, cid string, rev int32) (bson.M, error)
+}
+
+type AcStore interface {
+	Get(c context.Context, cid string, appid string) (bson.M, error)
+	Query(c context.Context, q *Query) (*QueryResult, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, cid string, appid string, u *Update) (bson.M, error)
+	Remove(c context.Context, cid string, appid string, rev int32) (bson.M, error)
+}
+
+/**
+* 幂等键记录，过期(etime)的记录视为不存在
+**/
+type IdempotencyStore interface {
+	Get(c context.Context, key string) (bson.M, error)
+	Create(c context.Context, doc bson.D) error
+	Update(c context.Context, key string, u *Update) (bson.M, error)
+	Remove(c context.Context, key string, rev int32) (bson.M, error)
+}
+
+/**
+* 全部集合的存储，处理函数只通过 Store 读写数据
+* Transaction 中 fn 须使用传入的 c 读写，返回错误时全部写入不生效(单节点 mongod 不支持事务，已完成的写入不回滚)
+**/
+type Store interface {
+	App() AppStore
+	Ver() VerStore
+	Container() ContainerStore
+	Ac() AcStore
+	Idempotency() IdempotencyStore
+	Collection(name string) Collection
+	Transaction(c context.Context, fn func(c context.Context) error) error
+	Watch(c context.Context, filters map[string]bson.D, token string, fn func(e *ChangeEvent) error) error
+}
+
+/**
+* 存储后端，keys 为集合的唯一键字段
+**/
+type backend interface {
+	collection(name string, keys []string) Collection
+	transaction(c context.Context, fn func(c context.Context) error) error
+	watch(c context.Context, filters map[string]bson.D, token string, fn func(e *ChangeEvent) error) error
+}
+
+/**
+* 集合的唯一键，与 OnInit 中的唯一索引一致，未列出的集合以 _id 为唯一键
+**/
+var collectionKeys = map[string][]string{
+	"ver":          {"appid", "ver"},
+	"ac":           {"cid", "appid"},
+	"channel":      {"appid", "name"},
+	"role_binding": {"uid", "role", "appid", "cid"},
+	"rollout_item": {"rollout_id", "cid"},
+}
+
+type store struct {
+	lock        sync.Mutex
+	b           backend
+	colls       map[string]Collection
+	app         *appStore
+	ver         *verStore
+	container   *containerStore
+	ac          *acStore
+	idempotency *idempotencyStore
+}
+
+func newStore(b backend) Store {
+	s := &store{b: b, colls: map[string]Collection{}}
+	s.app = &appStore{s.Collection("app")}
+	s.ver = &verStore{s.Collection("ver")}
+	s.container = &containerStore{s.Collection("container")}
+	s.ac = &acStore{s.Collection("ac")}
+	s.idempotency = &idempotencyStore{s.Collection("idempotency")}
+	return s
+}
+
+func (s *store) App() AppStore {
+	return s.app
+}
+
+func (s *store) Ver() VerStore {
+	return s.ver
+}
+
+func (s *store) Container() ContainerStore {
+	return s.container
+}
+
+func (s *store) Ac() AcStore {
+	return s.ac
+}
+
+func (s *store) Idempotency() IdempotencyStore {
+	return s.idempotency
+}
+
+func (s *store) Collection(name string) Collection {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	coll, ok := s.colls[name]
+
+	if !ok {
+		keys, ok := collectionKeys[name]
+		if !ok {
+			keys = []string{"_id"}
+		}
+		coll = s.b.collection(name, keys)
+		s.colls[name] = coll
+	}
+
+	return coll
+}
+
+func (s *store) Transaction(c context.Context, fn func(c context.Context) error) error {
+	return s.b.transaction(c, fn)
+}
+
+/**
+* 监听集合变更直到 c 结束或 fn 返回错误，filters 以集合名为键，值为文档的顶层字段条件
+* token 为空时从当前开始监听，无效或已过期时返回 ErrResume
+**/
+func (s *store) Watch(c context.Context, filters map[string]bson.D, token string, fn func(e *ChangeEvent) error) error {
+	return s.b.watch(c, filters, token, fn)
+}
+
+type appStore struct {
+	coll Collection
+}
+
+func (s *appStore) Get(c context.Context, appid string) (bson.M, error) {
+	return s.coll.Get(c, bson.D{bson.E{"_id", appid}})
+}
+
+func (s *appStore) Query(c context.Context, q *Query) (*QueryResult, error) {
+	return s.coll.Query(c, q)
+}
+
+func (s *appStore) Create(c context.Context, doc bson.D) error {
+	return s.coll.Create(c, doc)
+}
+
+func (s *appStore) Update(c context.Context, appid string, u *Update) (bson.M, error) {
+	return s.coll.Update(c, bson.D{bson.E{"_id", appid}}, u)
+}
+
+func (s *appStore) Remove(c context.Context, appid string, rev int32) (bson.M, error) {
+	return s.coll.Remove(c, bson.D{bson.E{"_id", appid}}, rev)
+}
+
+type verStore struct {
+	coll Collection
+}
+
+func (s *verStore) Get(c context.Context, appid string, ver string) (bson.M, error) {
+	return s.coll.Get(c, bson.D{bson.E{"appid", appid}, bson.E{"ver", ver}})
+}
+
+func (s *verStore) Query(c context.Context, q *Query) (*QueryResult, error) {
+	return s.coll.Query(c, q)
+}
+
+func (s *verStore) Create(c context.Context, doc bson.D) error {
+	return s.coll.Create(c, doc)
+}
+
+func (s *verStore) Update(c context.Context, appid string, ver string, u *Update) (bson.M, error) {
+	return s.coll.Update(c, bson.D{bson.E{"appid", appid}, bson.E{"ver", ver}}, u)
+}
+
+func (s *verStore) Remove(c context.Context, appid string, ver string, rev int32) (bson.M, error) {
+	return s.coll.Remove(c, bson.D{bson.E{"appid", appid}, bson.E{"ver", ver}}, rev)
+}
+
+type containerStore struct {
+	coll Collection
+}
+
+func (s *containerStore) Get(c context.Context, cid string) (bson.M, error) {
+	return s.coll.Get(c, bson.D{bson.E{"_id", cid}})
+}
+
+func (s *containerStore) Query(c context.Context, q *Query) (*QueryResult, error) {
+	return s.coll.Query(c, q)
+}
+
+func (s *containerStore) Create(c context.Context, doc bson.D) error {
+	return s.coll.Create(c, doc)
+}
+
+func (s *containerStore) Update(c context.Context, cid string, u *Update) (bson.M, error) {
+	return s.coll.Update(c, bson.D{bson.E{"_id", cid}}, u)
+}
+
+func (s *containerStore) Remove(c context.Context, cid string, rev int32) (bson.M, error) {
+	return s.coll.Remove(c, bson.D{bson.E{"_id", cid}}, rev)
+}
+
+type acStore struct {
+	coll Collection
+}
+
+func (s *acStore) Get(c context.Context, cid string, appid string) (bson.M, error) {
+	return s.coll.Get(c, bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}})
+}
+
+func (s *acStore) Query(c context.Context, q *Query) (*QueryResult, error) {
+	return s.coll.Query(c, q)
+}
+
+func (s *acStore) Create(c context.Context, doc bson.D) error {
+	return s.coll.Create(c, doc)
+}
+
+func (s *acStore) Update(c context.Context, cid string, appid string, u *Update) (bson.M, error) {
+	return s.coll.Update(c, bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}}, u)
+}
+
+func (s *acStore) Remove(c context.Context, cid string, appid string, rev int32) (bson.M, error) {
+	return s.coll.Remove(c, bson.D{bson.E{"cid", cid}, bson.E{"appid", appid}}, rev)
+}
+
+type idempotencyStore struct {
+	coll Collection
+}
+
+func (s *idempotencyStore) Get(c context.Context, key string) (bson.M, error) {
+
+	rs, err := s.coll.Get(c, bson.D{bson.E{"_id", key}})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if etime, ok := rs["etime"].(primitive.DateTime); ok && etime.Time().Before(time.Now()) {
+		s.coll.Remove(c, bson.D{bson.E{"_id", key}}, 0)
+		return nil, ErrNotFound
+	}
+
+	return rs, nil
+}
+
+func (s *idempotencyStore) Create(c context.Context, doc bson.D) error {
+	return s.coll.Create(c, doc)
+}
+
+func (s *idempotencyStore) Update(c context.Context, key string, u *Update) (bson.M, error) {
+	return s.coll.Update(c, bson.D{bson.E{"_id", key}}, u)
+}
+
+func (s *idempotencyStore) Remove(c context.Context, key string, rev int32) (bson.M, error) {
+	return s.coll.Remove(c, bson.D{bson.E{"_id", key}}, rev)
+}
+
+/**
+* 是否存在满足条件的文档
+**/
+func exists(c context.Context, coll Collection, filter bson.D) (bool, error) {
+	items, err := coll.Find(c, filter, nil, 1)
+	if err != nil {
+		return false, err
+	}
+	return len(items) > 0, nil
+}
+
+/**
+* 查询单个文档，不存在时返回 nil
+**/
+func findDoc(c context.Context, coll Collection, filter bson.D) bson.M {
+	rs, err := coll.Get(c, filter)
+	if err != nil {
+		return nil
+	}
+	return rs
+}
+
+func (q *Query) sort() bson.D {
+	if len(q.Sort) == 0 {
+		return bson.D{bson.E{"ctime", -1}, bson.E{"_id", -1}}
+	}
+	return q.Sort
+}
+
+/**
+* 转换为 mongo 查询条件，不含游标条件
+**/
+func (q *Query) filter() (bson.D, error) {
+
+	filter := append(bson.D{}, q.Where...)
+
+	if q.Q != "" {
+
+		e, err := searchCond(q.Q, q.Match, q.IgnoreCase)
+
+		if err != nil {
+			return nil, err
+		}
+
+		filter = append(filter, e)
+	}
+
+	return applyFilter(filter, q.Filter, q.Status)
+}
+
+/**
+* 校验查询条件，错误为调用方输入错误
+**/
+func (q *Query) Check() error {
+
+	if q.Cursor != "" && q.P > 0 {
+		return fmt.Errorf("param cursor and p are mutually exclusive")
+	}
+
+	_, err := q.filter()
+
+	if err != nil {
+		return err
+	}
+
+	if q.Cursor != "" {
+		_, err = decodeCursor(q.sort(), q.Cursor)
+	}
+
+	return err
+}
+
+/**
+* 按总数生成分页信息
+**/
+func newPage(p int32, n int32, totalCount int64) *pb.Page {
+
+	count := int32(totalCount) / n
+
+	if int32(totalCount)%n != 0 {
+		count = count + 1
+	}
+
+	return &pb.Page{P: p, N: n, TotalCount: int32(totalCount), Count: count}
+}
+
+/**
+* 存储错误转换为错误码
+**/
+func storeErrno(err error, name string) (int32, string) {
+	switch err {
+	case ErrNotFound:
+		return ERRNO_NOT_FOUND, fmt.Sprintf("not found %s", name)
+	case ErrConflict:
+		return ERRNO_CONFLICT, fmt.Sprintf("%s rev conflict", name)
+	case ErrDuplicate:
+		return ERRNO_DUPLICATE, fmt.Sprintf("%s already exists", name)
+	}
+	return ERRNO_INTERNAL_SERVER, err.Error()
+}
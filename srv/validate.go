@@ -0,0 +1,92 @@
+package srv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ability-sh/abi-lib/dynamic"
+	"github.com/ability-sh/abi-micro-app/pb"
+)
+
+/**
+* 校验应用是否存在
+**/
+func checkApp(c context.Context, app *AppService, appid string) (int32, error) {
+
+	_, err := app.Store.App().Get(c, appid)
+
+	if err != nil {
+		if err == ErrNotFound {
+			return ERRNO_NOT_FOUND, fmt.Errorf("not found app %s", appid)
+		}
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	return ERRNO_OK, nil
+}
+
+/**
+* 校验容器是否存在
+**/
+func checkContainer(c context.Context, app *AppService, cid string) (int32, error) {
+
+	_, err := app.Store.Container().Get(c, cid)
+
+	if err != nil {
+		if err == ErrNotFound {
+			return ERRNO_NOT_FOUND, fmt.Errorf("not found container %s", cid)
+		}
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	return ERRNO_OK, nil
+}
+
+/**
+* 校验应用版本是否存在且未撤回，开启 ac-published 时版本必须为已发布状态
+**/
+func checkVer(c context.Context, app *AppService, appid string, ver string) (int32, error) {
+
+	rs, err := app.Store.Ver().Get(c, appid, ver)
+
+	if err != nil {
+		if err == ErrNotFound {
+			return ERRNO_NOT_FOUND, fmt.Errorf("not found ver %s/%s", appid, ver)
+		}
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	if pb.VerStatus(dynamic.IntValue(rs["status"], 0)) == pb.VerStatus_VER_YANKED {
+		return ERRNO_INPUT_DATA, fmt.Errorf("ver %s/%s yanked", appid, ver)
+	}
+
+	if app.AcPublished && int32(dynamic.IntValue(rs["status"], 0)) != int32(pb.VerStatus_VER_PUBLISHED) {
+		return ERRNO_INPUT_DATA, fmt.Errorf("ver %s/%s not published", appid, ver)
+	}
+
+	return ERRNO_OK, nil
+}
+
+/**
+* 校验容器应用绑定的容器、应用及版本
+**/
+func checkAc(c context.Context, app *AppService, cid string, appid string, ver string) (int32, error) {
+
+	errno, err := checkContainer(c, app, cid)
+
+	if err != nil {
+		return errno, err
+	}
+
+	errno, err = checkApp(c, app, appid)
+
+	if err != nil {
+		return errno, err
+	}
+
+	if ver != "" {
+		return checkVer(c, app, appid, ver)
+	}
+
+	return ERRNO_OK, nil
+}
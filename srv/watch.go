@@ -0,0 +1,204 @@
+package srv
+
+import (
+	"context"
+
+	"github.com/ability-sh/abi-micro-app/pb"
+	"github.com/ability-sh/abi-micro/grpc"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+/**
+* 记录监听范围内的容器应用，删除事件使用变更前的文档，变更前镜像不可用时按 _id 找回 cid/appid
+**/
+type acWatcher struct {
+	items map[interface{}]bson.M
+}
+
+func newAcWatcher(c context.Context, app *AppService, filter bson.D) (*acWatcher, error) {
+
+	items, err := app.Store.Collection("ac").Find(c, filter, nil, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	w := &acWatcher{items: map[interface{}]bson.M{}}
+
+	for _, item := range items {
+		w.items[item["_id"]] = item
+	}
+
+	return w, nil
+}
+
+func (w *acWatcher) event(e *ChangeEvent) (*pb.Ac, bool) {
+
+	a := &pb.Ac{}
+
+	if e.Type == "delete" {
+		rs, ok := w.items[e.Id]
+		delete(w.items, e.Id)
+		if e.Before != nil {
+			rs = e.Before
+		} else if !ok {
+			return nil, false
+		}
+		setAc(a, rs)
+		return a, true
+	}
+
+	if e.Doc == nil {
+		return nil, false
+	}
+
+	w.items[e.Id] = e.Doc
+
+	setAc(a, e.Doc)
+
+	return a, true
+}
+
+/**
+* 监听存储变更并推送事件，fn 返回 nil 时跳过该事件
+**/
+func watch(c context.Context, app *AppService, filters map[string]bson.D, resumeToken string, fn func(e *ChangeEvent) *pb.WatchEvent, send func(e *pb.WatchEvent) error) (int32, error) {
+
+	err := app.Store.Watch(c, filters, resumeToken, func(e *ChangeEvent) error {
+
+		ev := fn(e)
+
+		if ev == nil {
+			return nil
+		}
+
+		ev.Errno = ERRNO_OK
+		ev.Type = e.Type
+		ev.ResumeToken = e.Token
+
+		return send(ev)
+	})
+
+	if err == ErrResume {
+		return ERRNO_INPUT_DATA, err
+	}
+
+	if err != nil {
+		return ERRNO_INTERNAL_SERVER, err
+	}
+
+	return ERRNO_OK, nil
+}
+
+func (s *server) ContainerWatch(task *pb.ContainerWatchTask, stream pb.Service_ContainerWatchServer) error {
+
+	c := stream.Context()
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Cid == "" {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid"})
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()})
+	}
+
+	if task.ResumeToken == "" {
+
+		errno, err := checkContainer(c, app, task.Cid)
+
+		if err != nil {
+			return stream.Send(&pb.WatchEvent{Errno: errno, Errmsg: err.Error()})
+		}
+	}
+
+	w, err := newAcWatcher(c, app, bson.D{bson.E{"cid", task.Cid}})
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()})
+	}
+
+	filters := map[string]bson.D{
+		"container": {bson.E{"_id", task.Cid}},
+		"ac":        {bson.E{"cid", task.Cid}},
+	}
+
+	errno, err := watch(c, app, filters, task.ResumeToken, func(e *ChangeEvent) *pb.WatchEvent {
+
+		switch e.Coll {
+		case "container":
+			a := &pb.Container{Id: task.Cid}
+			if e.Doc != nil {
+				setContainer(a, e.Doc)
+			}
+			return &pb.WatchEvent{Container: a}
+		case "ac":
+			a, ok := w.event(e)
+			if ok {
+				return &pb.WatchEvent{Ac: a}
+			}
+		}
+
+		return nil
+	}, stream.Send)
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: errno, Errmsg: err.Error()})
+	}
+
+	return nil
+}
+
+func (s *server) AcWatch(task *pb.AcWatchTask, stream pb.Service_AcWatchServer) error {
+
+	c := stream.Context()
+
+	ctx := grpc.GetContext(c)
+
+	defer ctx.Recycle()
+
+	if task.Cid == "" && task.Appid == "" {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INPUT_DATA, Errmsg: "not found param cid or appid"})
+	}
+
+	app, err := GetAppService(ctx, SERVICE_APP)
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()})
+	}
+
+	filter := bson.D{}
+
+	if task.Cid != "" {
+		filter = append(filter, bson.E{"cid", task.Cid})
+	}
+
+	if task.Appid != "" {
+		filter = append(filter, bson.E{"appid", task.Appid})
+	}
+
+	w, err := newAcWatcher(c, app, filter)
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: ERRNO_INTERNAL_SERVER, Errmsg: err.Error()})
+	}
+
+	errno, err := watch(c, app, map[string]bson.D{"ac": filter}, task.ResumeToken, func(e *ChangeEvent) *pb.WatchEvent {
+		a, ok := w.event(e)
+		if ok {
+			return &pb.WatchEvent{Ac: a}
+		}
+		return nil
+	}, stream.Send)
+
+	if err != nil {
+		return stream.Send(&pb.WatchEvent{Errno: errno, Errmsg: err.Error()})
+	}
+
+	return nil
+}